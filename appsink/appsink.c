@@ -47,6 +47,23 @@ GstFlowReturn bufferHandlerC(GstElement* element, gpointer user_data)
   return GST_FLOW_OK;
 }
 
+GstFlowReturn gstBufferHandlerC(GstElement* element, gpointer user_data)
+{
+  HandlerUserData* ud = (HandlerUserData*)user_data;
+
+  GstSample* sample = NULL;
+  g_signal_emit_by_name(element, "pull-sample", &sample);
+  if (sample)
+  {
+    GstBuffer* buffer = gst_sample_get_buffer(sample);
+    if (buffer)
+      goGstBufferHandler(gst_buffer_ref(buffer), ud->id);
+    gst_sample_unref(sample);
+  }
+
+  return GST_FLOW_OK;
+}
+
 void registerBufferHandler(void* element, int id)
 {
   HandlerUserData* ud = (HandlerUserData*)malloc(sizeof(HandlerUserData));
@@ -55,3 +72,12 @@ void registerBufferHandler(void* element, int id)
   g_object_set(element, "emit-signals", TRUE, NULL);
   g_signal_connect(element, "new-sample", G_CALLBACK(bufferHandlerC), ud);
 }
+
+void registerGstBufferHandler(void* element, int id)
+{
+  HandlerUserData* ud = (HandlerUserData*)malloc(sizeof(HandlerUserData));
+  ud->id = id;
+
+  g_object_set(element, "emit-signals", TRUE, NULL);
+  g_signal_connect(element, "new-sample", G_CALLBACK(gstBufferHandlerC), ud);
+}
@@ -30,6 +30,10 @@ import (
 // BufferHandler is a stream buffer handler callback type.
 type BufferHandler func([]byte, int)
 
+// GstBufferHandler is a stream buffer handler callback type receiving
+// the buffer with its timestamps and flags.
+type GstBufferHandler func(*gst.Buffer)
+
 // AppSink is a wrapper of GStreamer AppSink element.
 type AppSink struct {
 	element *gst.Element
@@ -37,7 +41,8 @@ type AppSink struct {
 }
 
 type handlerInfo struct {
-	handler BufferHandler
+	handler    BufferHandler
+	gstHandler GstBufferHandler
 }
 
 var (
@@ -62,6 +67,23 @@ func New(e *gst.Element, cb BufferHandler) *AppSink {
 	return s
 }
 
+// NewWithBuffer creates a GStreamer AppSink element wrapper which passes
+// the received buffers as *gst.Buffer.
+func NewWithBuffer(e *gst.Element, cb GstBufferHandler) *AppSink {
+	id := atomic.AddInt32(&idCnt, 1)
+	s := &AppSink{
+		element: e,
+		id:      id,
+	}
+	handlerMutex.Lock()
+	handlers[id] = &handlerInfo{
+		gstHandler: cb,
+	}
+	handlerMutex.Unlock()
+	C.registerGstBufferHandler(e.UnsafePointer(), C.int(id))
+	return s
+}
+
 // Close stops AppSink handling and free resource.s
 func (s *AppSink) Close() {
 	handlerMutex.Lock()
@@ -80,3 +102,16 @@ func goBufferHandler(p unsafe.Pointer, len, samples, id C.int) {
 		log.Printf("Unhandled buffer received (id: %d)", int(id))
 	}
 }
+
+//export goGstBufferHandler
+func goGstBufferHandler(p unsafe.Pointer, id C.int) {
+	buf := gst.NewBufferFromPointer(p)
+	handlerMutex.RLock()
+	h, ok := handlers[int32(id)]
+	handlerMutex.RUnlock()
+	if ok {
+		h.gstHandler(buf)
+	} else {
+		log.Printf("Unhandled buffer received (id: %d)", int(id))
+	}
+}
@@ -25,6 +25,7 @@
 #include <gst/app/app.h>
 
 extern void goBufferHandler(void* buffer, int len, int samples, int id);
+extern void goGstBufferHandler(void* buffer, int id);
 
 typedef struct
 {
@@ -32,5 +33,6 @@ typedef struct
 } HandlerUserData;
 
 void registerBufferHandler(void* element, int id);
+void registerGstBufferHandler(void* element, int id);
 
 #endif  // APPSINK_H
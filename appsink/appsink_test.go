@@ -20,6 +20,7 @@ import (
 	"testing"
 	"time"
 
+	gst "github.com/seqsense/sq-gst-go"
 	"github.com/seqsense/sq-gst-go/appsrc"
 	"github.com/seqsense/sq-gst-go/gstlaunch"
 )
@@ -60,3 +61,53 @@ func TestAppSrcAppSink(t *testing.T) {
 		t.Errorf("appsink received wrong buffer, expected: %v, received: %v", pushed, received)
 	}
 }
+
+func TestAppSrcAppSink_gstBuffer(t *testing.T) {
+	l := gstlaunch.MustNew("appsrc name=src ! appsink name=sink sync=false")
+
+	ch := make(chan *gst.Buffer, 1)
+	gstSink, err := l.GetElement("sink")
+	if err != nil {
+		t.Fatalf("appsink element must be got")
+	}
+	sink := NewWithBuffer(gstSink, func(b *gst.Buffer) {
+		ch <- b
+	})
+	defer sink.Close()
+
+	gstSrc, err := l.GetElement("src")
+	if err != nil {
+		t.Fatalf("appsrc element must be got")
+	}
+	src := appsrc.New(gstSrc)
+
+	l.Start()
+	defer l.Kill()
+	<-time.After(time.Millisecond * 100)
+
+	pushed := []byte{0, 1, 2, 3, 4, 5, 6, 7}
+	buf, err := gst.NewBufferFromBytes(pushed)
+	if err != nil {
+		t.Fatalf("failed to create buffer: %v", err)
+	}
+	buf.SetPTS(time.Second)
+	buf.SetFlags(gst.BufferFlagDeltaUnit)
+	if err := src.PushGstBuffer(buf); err != nil {
+		t.Fatalf("failed to push buffer: %v", err)
+	}
+
+	select {
+	case b := <-ch:
+		if !bytes.Equal(b.Bytes(), pushed) {
+			t.Errorf("appsink received wrong buffer, expected: %v, received: %v", pushed, b.Bytes())
+		}
+		if b.PTS() != time.Second {
+			t.Errorf("expected PTS 1s, got %v", b.PTS())
+		}
+		if !b.HasFlags(gst.BufferFlagDeltaUnit) {
+			t.Error("buffer flags must be kept")
+		}
+	case <-time.After(time.Second):
+		t.Error("appsink must receive a buffer")
+	}
+}
@@ -32,6 +32,12 @@ void pushBuffer(void* element, void* buffer, int len)
   gst_app_src_push_buffer(GST_APP_SRC(element), buffer_gst);
 }
 
+GstFlowReturn pushGstBuffer(void* element, void* buffer)
+{
+  gst_buffer_ref(GST_BUFFER(buffer));
+  return gst_app_src_push_buffer(GST_APP_SRC(element), GST_BUFFER(buffer));
+}
+
 void sendEOS(void* element)
 {
   gst_app_src_end_of_stream(GST_APP_SRC(element));
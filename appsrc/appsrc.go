@@ -19,6 +19,7 @@ package appsrc
 import "C"
 
 import (
+	"fmt"
 	"unsafe"
 
 	gst "github.com/seqsense/sq-gst-go"
@@ -42,6 +43,15 @@ func (s *AppSrc) PushBuffer(buf []byte) {
 	C.pushBuffer(s.element.UnsafePointer(), unsafe.Pointer(&buf[0]), C.int(len(buf)))
 }
 
+// PushGstBuffer sends a buffer to the AppSrc keeping its timestamps and flags.
+// Error is returned if the AppSrc is not accepting buffers because of flushing or EOS.
+func (s *AppSrc) PushGstBuffer(buf *gst.Buffer) error {
+	if ret := C.pushGstBuffer(s.element.UnsafePointer(), buf.UnsafePointer()); ret != C.GST_FLOW_OK {
+		return fmt.Errorf("Failed to push buffer (flow return: %d)", int(ret))
+	}
+	return nil
+}
+
 // EOS sends end-of-stream message to the AppSrc.
 func (s *AppSrc) EOS() {
 	C.sendEOS(s.element.UnsafePointer())
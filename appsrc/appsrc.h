@@ -25,6 +25,7 @@
 #include <gst/app/app.h>
 
 void pushBuffer(void* element, void* buffer, int len);
+GstFlowReturn pushGstBuffer(void* element, void* buffer);
 void sendEOS(void* element);
 GstState getState(void* element);
 
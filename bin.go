@@ -0,0 +1,216 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gst
+
+// #cgo pkg-config: gobject-2.0 gstreamer-1.0
+// #include <stdlib.h>
+// #include <gst/gst.h>
+// GstElement* newBin(const char* name)
+// {
+//   return gst_object_ref_sink(gst_bin_new(name));
+// }
+// GstElement* newPipeline(const char* name)
+// {
+//   return gst_object_ref_sink(gst_pipeline_new(name));
+// }
+// gboolean isBin(void* element)
+// {
+//   return GST_IS_BIN(element);
+// }
+// gboolean isPipeline(void* element)
+// {
+//   return GST_IS_PIPELINE(element);
+// }
+// gboolean binAdd(void* bin, void* element)
+// {
+//   return gst_bin_add(bin, element);
+// }
+// gboolean binRemove(void* bin, void* element)
+// {
+//   return gst_bin_remove(bin, element);
+// }
+// GstElement* binGetByName(void* bin, const char* name)
+// {
+//   return gst_bin_get_by_name(bin, name);
+// }
+// gboolean linkElements(void* src, void* dest)
+// {
+//   return gst_element_link(src, dest);
+// }
+// gboolean linkElementsFiltered(void* src, void* dest, const char* caps_str)
+// {
+//   GstCaps* caps = gst_caps_from_string(caps_str);
+//   if (caps == NULL)
+//     return FALSE;
+//   gboolean ret = gst_element_link_filtered(src, dest, caps);
+//   gst_caps_unref(caps);
+//   return ret;
+// }
+// gboolean linkElementPads(void* src, const char* src_pad, void* dest, const char* dest_pad)
+// {
+//   return gst_element_link_pads(src, src_pad, dest, dest_pad);
+// }
+// void unlinkElements(void* src, void* dest)
+// {
+//   gst_element_unlink(src, dest);
+// }
+import "C"
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+// Bin is a wrapper of GstBin.
+type Bin struct {
+	*Element
+}
+
+// Pipeline is a wrapper of GstPipeline.
+type Pipeline struct {
+	*Bin
+}
+
+// NewBin creates a new empty bin.
+// If name is empty, unique name is assigned.
+func NewBin(name string) (*Bin, error) {
+	var cName *C.char
+	if name != "" {
+		cName = C.CString(name)
+		defer C.free(unsafe.Pointer(cName))
+	}
+	b := C.newBin(cName)
+	if b == nil {
+		return nil, fmt.Errorf("Failed to create bin")
+	}
+	return &Bin{Element: NewElement(unsafe.Pointer(b))}, nil
+}
+
+// NewPipeline creates a new empty pipeline.
+// If name is empty, unique name is assigned.
+func NewPipeline(name string) (*Pipeline, error) {
+	var cName *C.char
+	if name != "" {
+		cName = C.CString(name)
+		defer C.free(unsafe.Pointer(cName))
+	}
+	p := C.newPipeline(cName)
+	if p == nil {
+		return nil, fmt.Errorf("Failed to create pipeline")
+	}
+	return &Pipeline{Bin: &Bin{Element: NewElement(unsafe.Pointer(p))}}, nil
+}
+
+// ToBin returns the Bin wrapper of the element.
+// Error is returned if the element is not a bin.
+func ToBin(e *Element) (*Bin, error) {
+	if C.isBin(e.UnsafePointer()) == 0 {
+		return nil, fmt.Errorf("Element is not a bin")
+	}
+	return &Bin{Element: e}, nil
+}
+
+// ToPipeline returns the Pipeline wrapper of the element.
+// Error is returned if the element is not a pipeline.
+func ToPipeline(e *Element) (*Pipeline, error) {
+	if C.isPipeline(e.UnsafePointer()) == 0 {
+		return nil, fmt.Errorf("Element is not a pipeline")
+	}
+	return &Pipeline{Bin: &Bin{Element: e}}, nil
+}
+
+// Add adds the elements to the bin.
+// If any of the elements failed to be added, the elements already added
+// by the call are removed and the bin is left unchanged.
+func (b *Bin) Add(elements ...*Element) error {
+	for i, e := range elements {
+		if C.binAdd(b.UnsafePointer(), e.UnsafePointer()) == 0 {
+			for _, added := range elements[:i] {
+				C.binRemove(b.UnsafePointer(), added.UnsafePointer())
+			}
+			return fmt.Errorf("Failed to add %s to %s", e.Name(), b.Name())
+		}
+	}
+	return nil
+}
+
+// Remove removes the elements from the bin.
+// If any of the elements failed to be removed, the elements already removed
+// by the call are added back to the bin.
+// Note that the links of the elements added back are not restored.
+func (b *Bin) Remove(elements ...*Element) error {
+	for i, e := range elements {
+		if C.binRemove(b.UnsafePointer(), e.UnsafePointer()) == 0 {
+			for _, removed := range elements[:i] {
+				C.binAdd(b.UnsafePointer(), removed.UnsafePointer())
+			}
+			return fmt.Errorf("Failed to remove %s from %s", e.Name(), b.Name())
+		}
+	}
+	return nil
+}
+
+// GetElement finds the element in the bin by the name recursively.
+func (b *Bin) GetElement(name string) (*Element, error) {
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+	e := C.binGetByName(b.UnsafePointer(), cName)
+	if e == nil {
+		return nil, fmt.Errorf("Failed to get %s", name)
+	}
+	return NewElement(unsafe.Pointer(e)), nil
+}
+
+// Link links the element to the destination element.
+// Pads are automatically selected and requested if needed.
+func (s *Element) Link(dest *Element) error {
+	if C.linkElements(s.UnsafePointer(), dest.UnsafePointer()) == 0 {
+		return fmt.Errorf("Failed to link %s to %s", s.Name(), dest.Name())
+	}
+	return nil
+}
+
+// LinkFiltered links the element to the destination element using the caps as a filter.
+func (s *Element) LinkFiltered(dest *Element, caps string) error {
+	cCaps := C.CString(caps)
+	defer C.free(unsafe.Pointer(cCaps))
+	if C.linkElementsFiltered(s.UnsafePointer(), dest.UnsafePointer(), cCaps) == 0 {
+		return fmt.Errorf("Failed to link %s to %s with %s", s.Name(), dest.Name(), caps)
+	}
+	return nil
+}
+
+// LinkPads links the pad of the element to the pad of the destination element.
+// If the pad name is empty, any compatible pad is selected.
+func (s *Element) LinkPads(srcPad string, dest *Element, destPad string) error {
+	var cSrcPad, cDestPad *C.char
+	if srcPad != "" {
+		cSrcPad = C.CString(srcPad)
+		defer C.free(unsafe.Pointer(cSrcPad))
+	}
+	if destPad != "" {
+		cDestPad = C.CString(destPad)
+		defer C.free(unsafe.Pointer(cDestPad))
+	}
+	if C.linkElementPads(s.UnsafePointer(), cSrcPad, dest.UnsafePointer(), cDestPad) == 0 {
+		return fmt.Errorf("Failed to link %s:%s to %s:%s", s.Name(), srcPad, dest.Name(), destPad)
+	}
+	return nil
+}
+
+// Unlink unlinks all pads linked between the element and the destination element.
+func (s *Element) Unlink(dest *Element) {
+	C.unlinkElements(s.UnsafePointer(), dest.UnsafePointer())
+}
@@ -0,0 +1,130 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gst
+
+import (
+	"testing"
+)
+
+func mustNewElements(t *testing.T, factories ...string) []*Element {
+	var ret []*Element
+	for _, f := range factories {
+		e, err := NewElementFromFactory(f, "")
+		if err != nil {
+			t.Fatalf("Failed to create %s: %v", f, err)
+		}
+		ret = append(ret, e)
+	}
+	return ret
+}
+
+func TestPipeline(t *testing.T) {
+	p, err := NewPipeline("pipe")
+	if err != nil {
+		t.Fatalf("Failed to create pipeline: %v", err)
+	}
+	if n := p.Name(); n != "pipe" {
+		t.Errorf("Pipeline name must be \"pipe\", but got %s", n)
+	}
+
+	es := mustNewElements(t, "audiotestsrc", "queue", "fakesink")
+	if err := p.Add(es...); err != nil {
+		t.Fatalf("Failed to add elements: %v", err)
+	}
+	if err := p.Add(es[0]); err == nil {
+		t.Error("Adding the same element twice must fail")
+	}
+	if err := es[0].LinkFiltered(es[1], "audio/x-raw,channels=1"); err != nil {
+		t.Errorf("Failed to link elements: %v", err)
+	}
+	if err := es[1].LinkPads("src", es[2], "sink"); err != nil {
+		t.Errorf("Failed to link pads: %v", err)
+	}
+	if err := es[2].Link(es[0]); err == nil {
+		t.Error("Linking sink to source must fail")
+	}
+
+	e, err := p.GetElement(es[1].Name())
+	if err != nil {
+		t.Fatalf("Failed to get element: %v", err)
+	}
+	if e.UnsafePointer() != es[1].UnsafePointer() {
+		t.Error("GetElement returned wrong element")
+	}
+
+	es[1].Unlink(es[2])
+	sinkPad, err := es[2].StaticPad("sink")
+	if err != nil {
+		t.Fatalf("Failed to get pad: %v", err)
+	}
+	if _, err := sinkPad.Peer(); err == nil {
+		t.Error("Pad must be unlinked")
+	}
+
+	if err := p.Remove(es[2]); err != nil {
+		t.Errorf("Failed to remove element: %v", err)
+	}
+	if _, err := p.GetElement(es[2].Name()); err == nil {
+		t.Error("Removed element must not be found")
+	}
+}
+
+func TestToPipeline(t *testing.T) {
+	b, err := NewBin("")
+	if err != nil {
+		t.Fatalf("Failed to create bin: %v", err)
+	}
+	if _, err := ToPipeline(b.Element); err == nil {
+		t.Error("ToPipeline of bin must fail")
+	}
+	if _, err := ToBin(b.Element); err != nil {
+		t.Errorf("ToBin of bin must succeed: %v", err)
+	}
+
+	es := mustNewElements(t, "fakesink")
+	if _, err := ToBin(es[0]); err == nil {
+		t.Error("ToBin of fakesink must fail")
+	}
+}
+
+func TestBin_AddRemoveRollback(t *testing.T) {
+	p, err := NewPipeline("pipe")
+	if err != nil {
+		t.Fatalf("Failed to create pipeline: %v", err)
+	}
+	es := mustNewElements(t, "fakesrc", "queue", "fakesink")
+	src, queue, sink := es[0], es[1], es[2]
+
+	if err := p.Add(src, queue, src); err == nil {
+		t.Fatal("Adding the same element twice must fail")
+	}
+	for _, e := range []*Element{src, queue} {
+		if _, err := p.GetElement(e.Name()); err == nil {
+			t.Errorf("%s must be removed on the failure", e.Name())
+		}
+	}
+
+	if err := p.Add(src, queue); err != nil {
+		t.Fatalf("Failed to add elements: %v", err)
+	}
+	if err := p.Remove(src, queue, sink); err == nil {
+		t.Fatal("Removing the element not in the bin must fail")
+	}
+	for _, e := range []*Element{src, queue} {
+		if _, err := p.GetElement(e.Name()); err != nil {
+			t.Errorf("%s must be added back on the failure", e.Name())
+		}
+	}
+}
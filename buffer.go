@@ -0,0 +1,389 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gst
+
+// #cgo pkg-config: gobject-2.0 gstreamer-1.0
+// #include <stdlib.h>
+// #include <string.h>
+// #include <gst/gst.h>
+// void refBuffer(GstBuffer* buffer)
+// {
+//   gst_mini_object_ref(GST_MINI_OBJECT_CAST(buffer));
+// }
+// void unrefBuffer(GstBuffer* buffer)
+// {
+//   gst_mini_object_unref(GST_MINI_OBJECT_CAST(buffer));
+// }
+// GstBuffer* makeBufferWritable(GstBuffer* buffer)
+// {
+//   return GST_BUFFER_CAST(gst_mini_object_make_writable(GST_MINI_OBJECT_CAST(buffer)));
+// }
+// gboolean isBufferWritable(GstBuffer* buffer)
+// {
+//   return gst_mini_object_is_writable(GST_MINI_OBJECT_CAST(buffer));
+// }
+// GstClockTime getBufferPTS(GstBuffer* buffer) { return GST_BUFFER_PTS(buffer); }
+// GstClockTime getBufferDTS(GstBuffer* buffer) { return GST_BUFFER_DTS(buffer); }
+// GstClockTime getBufferDuration(GstBuffer* buffer) { return GST_BUFFER_DURATION(buffer); }
+// guint64 getBufferOffset(GstBuffer* buffer) { return GST_BUFFER_OFFSET(buffer); }
+// guint64 getBufferOffsetEnd(GstBuffer* buffer) { return GST_BUFFER_OFFSET_END(buffer); }
+// void setBufferPTS(GstBuffer* buffer, GstClockTime t) { GST_BUFFER_PTS(buffer) = t; }
+// void setBufferDTS(GstBuffer* buffer, GstClockTime t) { GST_BUFFER_DTS(buffer) = t; }
+// void setBufferDuration(GstBuffer* buffer, GstClockTime t) { GST_BUFFER_DURATION(buffer) = t; }
+// void setBufferOffset(GstBuffer* buffer, guint64 o) { GST_BUFFER_OFFSET(buffer) = o; }
+// void setBufferOffsetEnd(GstBuffer* buffer, guint64 o) { GST_BUFFER_OFFSET_END(buffer) = o; }
+// GstBufferFlags getBufferFlags(GstBuffer* buffer) { return GST_BUFFER_FLAGS(buffer); }
+// void setBufferFlags(GstBuffer* buffer, GstBufferFlags flags) { GST_BUFFER_FLAG_SET(buffer, flags); }
+// void unsetBufferFlags(GstBuffer* buffer, GstBufferFlags flags) { GST_BUFFER_FLAG_UNSET(buffer, flags); }
+// GstMapInfo* mapBuffer(GstBuffer* buffer, GstMapFlags flags)
+// {
+//   GstMapInfo* info = malloc(sizeof(GstMapInfo));
+//   if (!gst_buffer_map(buffer, info, flags))
+//   {
+//     free(info);
+//     return NULL;
+//   }
+//   return info;
+// }
+// void unmapBuffer(GstBuffer* buffer, GstMapInfo* info)
+// {
+//   gst_buffer_unmap(buffer, info);
+//   free(info);
+// }
+// GstBuffer* newBufferFromBytes(void* data, gsize size)
+// {
+//   GstBuffer* buffer = gst_buffer_new_allocate(NULL, size, NULL);
+//   if (buffer != NULL && size > 0)
+//     gst_buffer_fill(buffer, 0, data, size);
+//   return buffer;
+// }
+// GstMeta* iterateBufferMeta(GstBuffer* buffer, gpointer* state)
+// {
+//   return gst_buffer_iterate_meta(buffer, state);
+// }
+// GType getMetaAPI(GstMeta* meta)
+// {
+//   return meta->info->api;
+// }
+import "C"
+
+import (
+	"fmt"
+	"runtime"
+	"time"
+	"unsafe"
+)
+
+// BufferFlags is a bit mask of the buffer flags.
+type BufferFlags uint
+
+const (
+	// BufferFlagLive states that the buffer is produced by a live source.
+	BufferFlagLive BufferFlags = C.GST_BUFFER_FLAG_LIVE
+	// BufferFlagDecodeOnly states that the buffer should be decoded but not rendered.
+	BufferFlagDecodeOnly BufferFlags = C.GST_BUFFER_FLAG_DECODE_ONLY
+	// BufferFlagDiscont states that the buffer is discontinuous from the previous one.
+	BufferFlagDiscont BufferFlags = C.GST_BUFFER_FLAG_DISCONT
+	// BufferFlagResync states that the timestamps might have a discontinuity.
+	BufferFlagResync BufferFlags = C.GST_BUFFER_FLAG_RESYNC
+	// BufferFlagCorrupted states that the data might be corrupted.
+	BufferFlagCorrupted BufferFlags = C.GST_BUFFER_FLAG_CORRUPTED
+	// BufferFlagMarker states the media specific marker like the end of the RTP frame.
+	BufferFlagMarker BufferFlags = C.GST_BUFFER_FLAG_MARKER
+	// BufferFlagHeader states that the buffer contains the stream header.
+	BufferFlagHeader BufferFlags = C.GST_BUFFER_FLAG_HEADER
+	// BufferFlagGap states that the buffer is a filler of the gap in the stream.
+	BufferFlagGap BufferFlags = C.GST_BUFFER_FLAG_GAP
+	// BufferFlagDroppable states that the buffer can be dropped without breaking the stream.
+	BufferFlagDroppable BufferFlags = C.GST_BUFFER_FLAG_DROPPABLE
+	// BufferFlagDeltaUnit states that the buffer can not be decoded independently.
+	BufferFlagDeltaUnit BufferFlags = C.GST_BUFFER_FLAG_DELTA_UNIT
+	// BufferFlagSyncAfter states that the downstream elements should sync after the buffer.
+	BufferFlagSyncAfter BufferFlags = C.GST_BUFFER_FLAG_SYNC_AFTER
+)
+
+// BufferOffsetNone is the offset value of the buffer which has no offset.
+const BufferOffsetNone = ^uint64(0)
+
+// MapFlags is a bit mask of the access mode of the memory mapping.
+type MapFlags uint
+
+const (
+	// MapRead maps the memory to be read.
+	MapRead MapFlags = C.GST_MAP_READ
+	// MapWrite maps the memory to be written.
+	MapWrite MapFlags = C.GST_MAP_WRITE
+)
+
+// Buffer is a wrapper of GstBuffer.
+// Setters and writable mapping make the buffer writable by copying it
+// if it is shared with others.
+type Buffer struct {
+	p *C.GstBuffer
+}
+
+// NewBuffer allocates a new buffer of the size.
+func NewBuffer(size int) (*Buffer, error) {
+	if size < 0 {
+		return nil, fmt.Errorf("Buffer size must not be negative")
+	}
+	p := C.gst_buffer_new_allocate(nil, C.gsize(size), nil)
+	if p == nil {
+		return nil, fmt.Errorf("Failed to allocate buffer")
+	}
+	return newBuffer(p), nil
+}
+
+// NewBufferFromBytes creates a new buffer containing a copy of the data.
+func NewBufferFromBytes(b []byte) (*Buffer, error) {
+	var data unsafe.Pointer
+	if len(b) > 0 {
+		data = unsafe.Pointer(&b[0])
+	}
+	p := C.newBufferFromBytes(data, C.gsize(len(b)))
+	if p == nil {
+		return nil, fmt.Errorf("Failed to allocate buffer")
+	}
+	return newBuffer(p), nil
+}
+
+// NewBufferFromPointer creates a new GstBuffer wrapper from given raw pointer.
+// The wrapper takes the ownership of the reference.
+func NewBufferFromPointer(p unsafe.Pointer) *Buffer {
+	return newBuffer((*C.GstBuffer)(p))
+}
+
+func newBuffer(p *C.GstBuffer) *Buffer {
+	b := &Buffer{p: p}
+	runtime.SetFinalizer(b, finalizeBuffer)
+	return b
+}
+
+func newBufferRef(p *C.GstBuffer) *Buffer {
+	C.refBuffer(p)
+	return newBuffer(p)
+}
+
+func finalizeBuffer(b *Buffer) {
+	C.unrefBuffer(b.p)
+}
+
+// UnsafePointer returns the raw pointer of the buffer.
+func (b *Buffer) UnsafePointer() unsafe.Pointer {
+	return unsafe.Pointer(b.p)
+}
+
+func (b *Buffer) makeWritable() {
+	b.p = C.makeBufferWritable(b.p)
+}
+
+// Size returns the size of the buffer data.
+func (b *Buffer) Size() int {
+	return int(C.gst_buffer_get_size(b.p))
+}
+
+// Bytes returns a copy of the buffer data.
+func (b *Buffer) Bytes() []byte {
+	return goBufferBytes(b.p)
+}
+
+// PTS returns the presentation timestamp of the buffer.
+// Negative value is returned if the timestamp is unknown.
+func (b *Buffer) PTS() time.Duration {
+	return time.Duration(int64(C.getBufferPTS(b.p)))
+}
+
+// SetPTS sets the presentation timestamp of the buffer.
+// Negative value means unknown.
+func (b *Buffer) SetPTS(t time.Duration) {
+	b.makeWritable()
+	C.setBufferPTS(b.p, clockTime(t))
+}
+
+// DTS returns the decoding timestamp of the buffer.
+// Negative value is returned if the timestamp is unknown.
+func (b *Buffer) DTS() time.Duration {
+	return time.Duration(int64(C.getBufferDTS(b.p)))
+}
+
+// SetDTS sets the decoding timestamp of the buffer.
+// Negative value means unknown.
+func (b *Buffer) SetDTS(t time.Duration) {
+	b.makeWritable()
+	C.setBufferDTS(b.p, clockTime(t))
+}
+
+// Duration returns the duration of the buffer.
+// Negative value is returned if the duration is unknown.
+func (b *Buffer) Duration() time.Duration {
+	return time.Duration(int64(C.getBufferDuration(b.p)))
+}
+
+// SetDuration sets the duration of the buffer.
+// Negative value means unknown.
+func (b *Buffer) SetDuration(t time.Duration) {
+	b.makeWritable()
+	C.setBufferDuration(b.p, clockTime(t))
+}
+
+// Offset returns the media specific offset of the buffer like the frame number.
+// BufferOffsetNone is returned if the offset is unknown.
+func (b *Buffer) Offset() uint64 {
+	return uint64(C.getBufferOffset(b.p))
+}
+
+// SetOffset sets the media specific offset of the buffer.
+func (b *Buffer) SetOffset(o uint64) {
+	b.makeWritable()
+	C.setBufferOffset(b.p, C.guint64(o))
+}
+
+// OffsetEnd returns the media specific offset of the end of the buffer.
+// BufferOffsetNone is returned if the offset is unknown.
+func (b *Buffer) OffsetEnd() uint64 {
+	return uint64(C.getBufferOffsetEnd(b.p))
+}
+
+// SetOffsetEnd sets the media specific offset of the end of the buffer.
+func (b *Buffer) SetOffsetEnd(o uint64) {
+	b.makeWritable()
+	C.setBufferOffsetEnd(b.p, C.guint64(o))
+}
+
+// Flags returns the flags of the buffer.
+func (b *Buffer) Flags() BufferFlags {
+	return BufferFlags(C.getBufferFlags(b.p))
+}
+
+// HasFlags returns true if the buffer has all of the flags.
+func (b *Buffer) HasFlags(flags BufferFlags) bool {
+	return b.Flags()&flags == flags
+}
+
+// SetFlags sets the flags of the buffer.
+func (b *Buffer) SetFlags(flags BufferFlags) {
+	b.makeWritable()
+	C.setBufferFlags(b.p, C.GstBufferFlags(flags))
+}
+
+// UnsetFlags clears the flags of the buffer.
+func (b *Buffer) UnsetFlags(flags BufferFlags) {
+	b.makeWritable()
+	C.unsetBufferFlags(b.p, C.GstBufferFlags(flags))
+}
+
+// Copy returns a deep copy of the buffer including the data.
+func (b *Buffer) Copy() *Buffer {
+	return newBuffer(C.gst_buffer_copy_deep(b.p))
+}
+
+// SubBuffer returns a new buffer sharing the region of the data.
+// The timestamps and the flags are copied.
+func (b *Buffer) SubBuffer(offset, size int) (*Buffer, error) {
+	if offset < 0 || size < 0 || b.Size() < offset+size {
+		return nil, fmt.Errorf("Region %d+%d out of range", offset, size)
+	}
+	p := C.gst_buffer_copy_region(b.p, C.GST_BUFFER_COPY_ALL, C.gsize(offset), C.gsize(size))
+	if p == nil {
+		return nil, fmt.Errorf("Failed to copy region %d+%d", offset, size)
+	}
+	return newBuffer(p), nil
+}
+
+// Meta describes the metadata attached to the buffer.
+type Meta struct {
+	// API is a GType name of the meta API like "GstVideoMetaAPI".
+	API string
+	// Tags are the tags of the meta API like "video" and "size".
+	Tags []string
+}
+
+// Metas returns the metadata attached to the buffer.
+func (b *Buffer) Metas() []Meta {
+	var ret []Meta
+	var state C.gpointer
+	for {
+		m := C.iterateBufferMeta(b.p, &state)
+		if m == nil {
+			break
+		}
+		api := C.getMetaAPI(m)
+		meta := Meta{API: typeName(api)}
+		if tags := C.gst_meta_api_type_get_tags(api); tags != nil {
+			ts := (*[1 << 16]*C.gchar)(unsafe.Pointer(tags))
+			for i := 0; ts[i] != nil; i++ {
+				meta.Tags = append(meta.Tags, C.GoString(ts[i]))
+			}
+		}
+		ret = append(ret, meta)
+	}
+	return ret
+}
+
+// Map maps the buffer data to be accessed directly.
+// The mapping must be released by BufferMap.Unmap.
+// Writable mapping makes the buffer writable.
+// The mapping holds a reference to the mapped GstBuffer,
+// so setters called before Unmap make the buffer writable by copying it
+// and don't affect the mapped data.
+func (b *Buffer) Map(flags MapFlags) (*BufferMap, error) {
+	if flags&MapWrite != 0 {
+		b.makeWritable()
+	}
+	p := b.p
+	info := C.mapBuffer(p, C.GstMapFlags(flags))
+	if info == nil {
+		return nil, fmt.Errorf("Failed to map buffer")
+	}
+	// Take the reference after mapping since writable mapping requires
+	// the buffer to be writable.
+	C.refBuffer(p)
+	return &BufferMap{buffer: p, info: info}, nil
+}
+
+// BufferMap is a memory mapping of the buffer.
+type BufferMap struct {
+	buffer *C.GstBuffer
+	info   *C.GstMapInfo
+}
+
+// Data returns the mapped memory.
+// The slice must not be used after Unmap.
+func (m *BufferMap) Data() []byte {
+	if m.info == nil || m.info.size == 0 {
+		return nil
+	}
+	n := int(m.info.size)
+	return unsafe.Slice((*byte)(unsafe.Pointer(m.info.data)), n)
+}
+
+// Unmap releases the mapping.
+// It is safe to call Unmap multiple times.
+func (m *BufferMap) Unmap() {
+	if m.info == nil {
+		return
+	}
+	C.unmapBuffer(m.buffer, m.info)
+	C.unrefBuffer(m.buffer)
+	m.info = nil
+	m.buffer = nil
+}
+
+func clockTime(t time.Duration) C.GstClockTime {
+	if t < 0 {
+		return ^C.GstClockTime(0)
+	}
+	return C.GstClockTime(t)
+}
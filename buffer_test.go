@@ -0,0 +1,129 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gst
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestBuffer(t *testing.T) {
+	data := []byte{0, 1, 2, 3, 4, 5, 6, 7}
+	b, err := NewBufferFromBytes(data)
+	if err != nil {
+		t.Fatalf("Failed to create buffer: %v", err)
+	}
+	if n := b.Size(); n != len(data) {
+		t.Errorf("Expected size %d, got %d", len(data), n)
+	}
+	if !bytes.Equal(b.Bytes(), data) {
+		t.Errorf("Expected data %v, got %v", data, b.Bytes())
+	}
+
+	if b.PTS() >= 0 || b.DTS() >= 0 || b.Duration() >= 0 {
+		t.Error("Timestamps of new buffer must be unknown")
+	}
+	if b.Offset() != BufferOffsetNone {
+		t.Error("Offset of new buffer must be unknown")
+	}
+	b.SetPTS(time.Second)
+	b.SetDTS(time.Millisecond)
+	b.SetDuration(10 * time.Millisecond)
+	b.SetOffset(1)
+	b.SetOffsetEnd(2)
+	b.SetFlags(BufferFlagDeltaUnit | BufferFlagDiscont)
+	if b.PTS() != time.Second || b.DTS() != time.Millisecond || b.Duration() != 10*time.Millisecond {
+		t.Errorf("Unexpected timestamps %v, %v, %v", b.PTS(), b.DTS(), b.Duration())
+	}
+	if b.Offset() != 1 || b.OffsetEnd() != 2 {
+		t.Errorf("Unexpected offsets %d, %d", b.Offset(), b.OffsetEnd())
+	}
+	if !b.HasFlags(BufferFlagDeltaUnit | BufferFlagDiscont) {
+		t.Errorf("Unexpected flags %x", b.Flags())
+	}
+	b.UnsetFlags(BufferFlagDiscont)
+	if b.HasFlags(BufferFlagDiscont) || !b.HasFlags(BufferFlagDeltaUnit) {
+		t.Errorf("Unexpected flags %x", b.Flags())
+	}
+
+	c := b.Copy()
+	m, err := b.Map(MapRead | MapWrite)
+	if err != nil {
+		t.Fatalf("Failed to map buffer: %v", err)
+	}
+	m.Data()[0] = 10
+	m.Unmap()
+	m.Unmap()
+	if d := b.Bytes(); d[0] != 10 {
+		t.Errorf("Written data must be stored, but got %v", d)
+	}
+	if d := c.Bytes(); d[0] != 0 {
+		t.Errorf("Copied buffer must not be changed, but got %v", d)
+	}
+	if c.PTS() != time.Second {
+		t.Errorf("Copied buffer must have the same PTS, but got %v", c.PTS())
+	}
+
+	sub, err := b.SubBuffer(2, 4)
+	if err != nil {
+		t.Fatalf("Failed to get sub-buffer: %v", err)
+	}
+	if !bytes.Equal(sub.Bytes(), data[2:6]) {
+		t.Errorf("Expected sub-buffer data %v, got %v", data[2:6], sub.Bytes())
+	}
+	if _, err := b.SubBuffer(4, 5); err == nil {
+		t.Error("Sub-buffer out of range must fail")
+	}
+	if n := len(b.Metas()); n != 0 {
+		t.Errorf("New buffer must not have metas, but got %d", n)
+	}
+}
+
+func TestBuffer_sharedWrite(t *testing.T) {
+	b, err := NewBuffer(4)
+	if err != nil {
+		t.Fatalf("Failed to create buffer: %v", err)
+	}
+	b.SetPTS(time.Second)
+	shared := newBufferRef(b.p)
+	shared.SetPTS(2 * time.Second)
+	if b.PTS() != time.Second {
+		t.Errorf("Setting PTS of the shared buffer must not affect others, but got %v", b.PTS())
+	}
+}
+
+func TestBuffer_setWhileMapped(t *testing.T) {
+	b, err := NewBufferFromBytes([]byte{0, 1, 2, 3})
+	if err != nil {
+		t.Fatalf("Failed to create buffer: %v", err)
+	}
+	m, err := b.Map(MapRead)
+	if err != nil {
+		t.Fatalf("Failed to map buffer: %v", err)
+	}
+	mapped := b.UnsafePointer()
+	b.SetPTS(time.Second)
+	if b.UnsafePointer() == mapped {
+		t.Error("Setter on the mapped buffer must make a copy")
+	}
+	if d := m.Data(); !bytes.Equal(d, []byte{0, 1, 2, 3}) {
+		t.Errorf("Mapped data must be kept, but got %v", d)
+	}
+	m.Unmap()
+	if b.PTS() != time.Second {
+		t.Errorf("Expected PTS %v, got %v", time.Second, b.PTS())
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gst
+
+// #cgo pkg-config: gobject-2.0 gstreamer-1.0
+// #include <stdlib.h>
+// #include <gst/gst.h>
+// void refCaps(GstCaps* caps)
+// {
+//   gst_mini_object_ref(GST_MINI_OBJECT_CAST(caps));
+// }
+// void unrefCaps(GstCaps* caps)
+// {
+//   gst_mini_object_unref(GST_MINI_OBJECT_CAST(caps));
+// }
+// GstCaps* refCapsRet(GstCaps* caps)
+// {
+//   refCaps(caps);
+//   return caps;
+// }
+import "C"
+
+import (
+	"fmt"
+	"runtime"
+	"unsafe"
+)
+
+// Caps is a wrapper of GstCaps which describes the media types.
+// Caps is immutable and the operations return new Caps.
+type Caps struct {
+	p *C.GstCaps
+}
+
+// ParseCaps creates a caps from the string representation
+// like "video/x-raw,format=I420;video/x-raw,format=NV12".
+func ParseCaps(str string) (*Caps, error) {
+	cStr := C.CString(str)
+	defer C.free(unsafe.Pointer(cStr))
+	p := C.gst_caps_from_string(cStr)
+	if p == nil {
+		return nil, fmt.Errorf("Failed to parse caps %s", str)
+	}
+	return newCaps(p), nil
+}
+
+// NewEmptyCaps creates a caps which matches nothing.
+func NewEmptyCaps() *Caps {
+	return newCaps(C.gst_caps_new_empty())
+}
+
+// NewAnyCaps creates a caps which matches any media type.
+func NewAnyCaps() *Caps {
+	return newCaps(C.gst_caps_new_any())
+}
+
+// NewCapsFromStructures creates a caps containing copies of the structures.
+func NewCapsFromStructures(ss ...*Structure) *Caps {
+	p := C.gst_caps_new_empty()
+	for _, s := range ss {
+		C.gst_caps_append_structure(p, C.gst_structure_copy(s.p))
+	}
+	return newCaps(p)
+}
+
+// newCaps creates a caps wrapper which takes the ownership.
+func newCaps(p *C.GstCaps) *Caps {
+	c := &Caps{p: p}
+	runtime.SetFinalizer(c, finalizeCaps)
+	return c
+}
+
+func newCapsRef(p *C.GstCaps) *Caps {
+	C.refCaps(p)
+	return newCaps(p)
+}
+
+func finalizeCaps(c *Caps) {
+	C.unrefCaps(c.p)
+}
+
+// UnsafePointer returns the raw pointer of the caps.
+func (c *Caps) UnsafePointer() unsafe.Pointer {
+	return unsafe.Pointer(c.p)
+}
+
+// String returns the string representation of the caps.
+func (c *Caps) String() string {
+	str := C.gst_caps_to_string(c.p)
+	defer C.g_free(C.gpointer(str))
+	return C.GoString(str)
+}
+
+// IsAny returns true if the caps matches any media type.
+func (c *Caps) IsAny() bool {
+	return C.gst_caps_is_any(c.p) != 0
+}
+
+// IsEmpty returns true if the caps matches nothing.
+func (c *Caps) IsEmpty() bool {
+	return C.gst_caps_is_empty(c.p) != 0
+}
+
+// IsFixed returns true if the caps has exactly one structure without
+// ranges and lists.
+func (c *Caps) IsFixed() bool {
+	return C.gst_caps_is_fixed(c.p) != 0
+}
+
+// IsEqual returns true if the caps describes the same media types.
+func (c *Caps) IsEqual(other *Caps) bool {
+	return C.gst_caps_is_equal(c.p, other.p) != 0
+}
+
+// IsSubset returns true if all media types of the caps are included in the superset.
+func (c *Caps) IsSubset(superset *Caps) bool {
+	return C.gst_caps_is_subset(c.p, superset.p) != 0
+}
+
+// CanIntersect returns true if the intersection of the caps is not empty.
+func (c *Caps) CanIntersect(other *Caps) bool {
+	return C.gst_caps_can_intersect(c.p, other.p) != 0
+}
+
+// Intersect returns the media types included in both caps.
+func (c *Caps) Intersect(other *Caps) *Caps {
+	return newCaps(C.gst_caps_intersect(c.p, other.p))
+}
+
+// Merge returns the caps containing the media types of both caps.
+// Structures of other which are subsets of the caps are not appended.
+func (c *Caps) Merge(other *Caps) *Caps {
+	return newCaps(C.gst_caps_merge(C.refCapsRet(c.p), C.refCapsRet(other.p)))
+}
+
+// Simplify returns the equivalent caps with the minimum number of structures.
+func (c *Caps) Simplify() *Caps {
+	return newCaps(C.gst_caps_simplify(C.refCapsRet(c.p)))
+}
+
+// Fixate returns the fixed caps by taking the first structure and
+// choosing the nearest values to the start of the ranges and lists.
+// Error is returned if the caps is empty or any.
+func (c *Caps) Fixate() (*Caps, error) {
+	if c.IsEmpty() || c.IsAny() {
+		return nil, fmt.Errorf("Caps %s can not be fixated", c)
+	}
+	return newCaps(C.gst_caps_fixate(C.refCapsRet(c.p))), nil
+}
+
+// Size returns the number of the structures.
+func (c *Caps) Size() int {
+	return int(C.gst_caps_get_size(c.p))
+}
+
+// Structure returns a copy of the i-th structure.
+func (c *Caps) Structure(i int) (*Structure, error) {
+	if i < 0 || c.Size() <= i {
+		return nil, fmt.Errorf("Structure index %d out of range", i)
+	}
+	return newStructure(C.gst_structure_copy(C.gst_caps_get_structure(c.p, C.guint(i)))), nil
+}
+
+// Structures returns copies of all structures.
+func (c *Caps) Structures() []*Structure {
+	n := c.Size()
+	ret := make([]*Structure, 0, n)
+	for i := 0; i < n; i++ {
+		s, _ := c.Structure(i)
+		ret = append(ret, s)
+	}
+	return ret
+}
@@ -0,0 +1,176 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gst
+
+import (
+	"reflect"
+	"testing"
+)
+
+func mustParseCaps(t *testing.T, str string) *Caps {
+	c, err := ParseCaps(str)
+	if err != nil {
+		t.Fatalf("Failed to parse caps %s: %v", str, err)
+	}
+	return c
+}
+
+func TestCaps_fields(t *testing.T) {
+	c := mustParseCaps(t,
+		"video/x-raw,format={I420,NV12},width=[1,1920],height=(int)480,"+
+			"framerate=30/1,pixel-aspect-ratio=[1/2,2/1],level=<1,2>")
+	if n := c.Size(); n != 1 {
+		t.Fatalf("Caps must have 1 structure, but got %d", n)
+	}
+	s, err := c.Structure(0)
+	if err != nil {
+		t.Fatalf("Failed to get structure: %v", err)
+	}
+	if n := s.Name(); n != "video/x-raw" {
+		t.Errorf("Expected structure name \"video/x-raw\", got %s", n)
+	}
+	expected := map[string]interface{}{
+		"format":             ValueList{"I420", "NV12"},
+		"width":              IntRange{Min: 1, Max: 1920, Step: 1},
+		"height":             480,
+		"framerate":          Fraction{Num: 30, Denom: 1},
+		"pixel-aspect-ratio": FractionRange{Min: Fraction{1, 2}, Max: Fraction{2, 1}},
+		"level":              ValueArray{1, 2},
+	}
+	for k, v := range expected {
+		got, err := s.Get(k)
+		if err != nil {
+			t.Errorf("Failed to get %s: %v", k, err)
+			continue
+		}
+		if !reflect.DeepEqual(v, got) {
+			t.Errorf("Expected %s=%v (%T), got %v (%T)", k, v, v, got, got)
+		}
+	}
+
+	s2, err := NewStructure("audio/x-raw")
+	if err != nil {
+		t.Fatalf("Failed to create structure: %v", err)
+	}
+	for k, v := range map[string]interface{}{
+		"rate":     IntRange{Min: 8000, Max: 48000},
+		"channels": ValueList{1, 2},
+		"gain":     DoubleRange{Min: 0, Max: 1},
+	} {
+		if err := s2.Set(k, v); err != nil {
+			t.Errorf("Failed to set %s: %v", k, err)
+		}
+	}
+	if err := s2.Set("invalid", IntRange{Min: 2, Max: 1}); err == nil {
+		t.Error("Setting invalid range must fail")
+	}
+	c2 := NewCapsFromStructures(s2)
+	if !c2.IsEqual(mustParseCaps(t, "audio/x-raw,rate=[8000,48000],channels={1,2},gain=[0.0,1.0]")) {
+		t.Errorf("Unexpected caps %s", c2)
+	}
+}
+
+func TestCaps_operations(t *testing.T) {
+	if _, err := ParseCaps("video/x-raw,width="); err == nil {
+		t.Error("Parsing invalid caps must fail")
+	}
+
+	c := mustParseCaps(t, "video/x-raw,width=[1,1920],height=[1,1080]")
+	fixed := mustParseCaps(t, "video/x-raw,width=640,height=480")
+	other := mustParseCaps(t, "video/x-raw,width=[640,3840]")
+
+	if c.IsFixed() {
+		t.Error("Caps with ranges must not be fixed")
+	}
+	if !fixed.IsFixed() {
+		t.Error("Caps without ranges must be fixed")
+	}
+	if !fixed.IsSubset(c) {
+		t.Errorf("%s must be a subset of %s", fixed, c)
+	}
+	if c.IsSubset(fixed) {
+		t.Errorf("%s must not be a subset of %s", c, fixed)
+	}
+
+	i := c.Intersect(other)
+	if !i.IsEqual(mustParseCaps(t, "video/x-raw,width=[640,1920],height=[1,1080]")) {
+		t.Errorf("Unexpected intersection %s", i)
+	}
+	if !c.CanIntersect(other) {
+		t.Error("Caps must be intersectable")
+	}
+	if c.Intersect(mustParseCaps(t, "audio/x-raw")).IsEmpty() != true {
+		t.Error("Intersection of video and audio must be empty")
+	}
+
+	f, err := c.Fixate()
+	if err != nil {
+		t.Fatalf("Failed to fixate caps: %v", err)
+	}
+	if !f.IsFixed() {
+		t.Errorf("Fixated caps %s must be fixed", f)
+	}
+	if _, err := NewAnyCaps().Fixate(); err == nil {
+		t.Error("Fixating any caps must fail")
+	}
+	if _, err := NewEmptyCaps().Fixate(); err == nil {
+		t.Error("Fixating empty caps must fail")
+	}
+
+	m := fixed.Merge(mustParseCaps(t, "audio/x-raw"))
+	if n := m.Size(); n != 2 {
+		t.Errorf("Merged caps must have 2 structures, but got %d", n)
+	}
+	if n := len(m.Structures()); n != 2 {
+		t.Errorf("Merged caps must have 2 structures, but got %d", n)
+	}
+	if n := fixed.Size(); n != 1 {
+		t.Errorf("Original caps must not be changed, but got %d structures", n)
+	}
+
+	s := mustParseCaps(t, "video/x-raw,width=640;video/x-raw,width=[1,1920]").Simplify()
+	if n := s.Size(); n != 1 {
+		t.Errorf("Simplified caps must have 1 structure, but got %s", s)
+	}
+}
+
+func TestCaps_property(t *testing.T) {
+	e, err := NewElementFromFactory("capsfilter", "")
+	if err != nil {
+		t.Fatalf("Failed to create capsfilter: %v", err)
+	}
+	c := mustParseCaps(t, "audio/x-raw,channels=2")
+	if err := e.SetProperty("caps", c); err != nil {
+		t.Fatalf("Failed to set caps property: %v", err)
+	}
+	v, err := e.GetProperty("caps")
+	if err != nil {
+		t.Fatalf("Failed to get caps property: %v", err)
+	}
+	got, ok := v.(*Caps)
+	if !ok {
+		t.Fatalf("Caps property must be returned as *Caps, but got %T", v)
+	}
+	if !got.IsEqual(c) {
+		t.Errorf("Expected caps %s, got %s", c, got)
+	}
+
+	if err := e.SetProperty("caps", "video/x-raw"); err != nil {
+		t.Fatalf("Failed to set caps property by string: %v", err)
+	}
+	if v, _ := e.GetProperty("caps"); v.(*Caps).String() != "video/x-raw" {
+		t.Errorf("Expected caps video/x-raw, got %s", v)
+	}
+}
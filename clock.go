@@ -0,0 +1,206 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gst
+
+// #cgo pkg-config: gobject-2.0 gstreamer-1.0
+// #include <gst/gst.h>
+// #if !GST_CHECK_VERSION(1, 18, 0)
+// #define GST_CLOCK_TYPE_TAI 3
+// #endif
+// GstClock* newSystemClock(GstClockType type)
+// {
+//   return gst_object_ref_sink(g_object_new(GST_TYPE_SYSTEM_CLOCK, "clock-type", type, NULL));
+// }
+// gboolean isClockTypeSupported(GstClockType type)
+// {
+//   GEnumClass* klass = g_type_class_ref(GST_TYPE_CLOCK_TYPE);
+//   gboolean ret = g_enum_get_value(klass, type) != NULL;
+//   g_type_class_unref(klass);
+//   return ret;
+// }
+// GstClockTime getClockTime(void* clock)
+// {
+//   return gst_clock_get_time(clock);
+// }
+// GstClockTime getClockResolution(void* clock)
+// {
+//   return gst_clock_get_resolution(clock);
+// }
+// gboolean waitClockForSync(void* clock, gint64 timeout)
+// {
+//   GstClockTime t = GST_CLOCK_TIME_NONE;
+//   if (timeout >= 0)
+//     t = timeout;
+//   return gst_clock_wait_for_sync(clock, t);
+// }
+// gboolean isClockSynced(void* clock)
+// {
+//   return gst_clock_is_synced(clock);
+// }
+// GstClock* getElementClock(void* element)
+// {
+//   return gst_element_get_clock(element);
+// }
+// GstClockTime getElementBaseTime(void* element)
+// {
+//   return gst_element_get_base_time(element);
+// }
+// GstClock* getPipelineClock(void* pipeline)
+// {
+//   return gst_pipeline_get_clock(pipeline);
+// }
+// void pipelineUseClock(void* pipeline, void* clock)
+// {
+//   gst_pipeline_use_clock(pipeline, clock);
+// }
+// void pipelineAutoClock(void* pipeline)
+// {
+//   gst_pipeline_auto_clock(pipeline);
+// }
+import "C"
+
+import (
+	"fmt"
+	"runtime"
+	"time"
+	"unsafe"
+)
+
+// ClockType is a type of the time source of the system clock.
+type ClockType int
+
+const (
+	// ClockTypeRealtime is the wall clock time which may jump.
+	ClockTypeRealtime ClockType = C.GST_CLOCK_TYPE_REALTIME
+	// ClockTypeMonotonic is the monotonic time since an unspecified starting point.
+	ClockTypeMonotonic ClockType = C.GST_CLOCK_TYPE_MONOTONIC
+	// ClockTypeOther is a platform specific time source.
+	ClockTypeOther ClockType = C.GST_CLOCK_TYPE_OTHER
+	// ClockTypeTAI is the International Atomic Time. It requires GStreamer 1.18 or later.
+	ClockTypeTAI ClockType = C.GST_CLOCK_TYPE_TAI
+)
+
+// Clock is a wrapper of GstClock.
+type Clock struct {
+	p unsafe.Pointer
+}
+
+// NewClock creates a new GstClock wrapper from given raw pointer.
+// The wrapper takes the ownership of the reference.
+func NewClock(p unsafe.Pointer) *Clock {
+	c := &Clock{p: p}
+	runtime.SetFinalizer(c, finalizeClock)
+	return c
+}
+
+func finalizeClock(c *Clock) {
+	C.gst_object_unref(C.gpointer(c.p))
+}
+
+// NewSystemClock creates a new system clock using the time source of the type.
+// Error is returned if the type is not supported by the GStreamer runtime
+// like ClockTypeTAI on GStreamer older than 1.18.
+func NewSystemClock(t ClockType) (*Clock, error) {
+	if C.isClockTypeSupported(C.GstClockType(t)) == 0 {
+		return nil, fmt.Errorf("Clock type %d is not supported", int(t))
+	}
+	return NewClock(unsafe.Pointer(C.newSystemClock(C.GstClockType(t)))), nil
+}
+
+// UnsafePointer returns the raw pointer of the clock.
+func (c *Clock) UnsafePointer() unsafe.Pointer {
+	return c.p
+}
+
+// Name returns the name of the clock.
+func (c *Clock) Name() string {
+	name := C.gst_object_get_name((*C.GstObject)(c.p))
+	defer C.g_free(C.gpointer(name))
+	return C.GoString(name)
+}
+
+// Time returns the current time of the clock.
+func (c *Clock) Time() time.Duration {
+	return time.Duration(C.getClockTime(c.p))
+}
+
+// Resolution returns the accuracy of the clock.
+func (c *Clock) Resolution() time.Duration {
+	return time.Duration(C.getClockResolution(c.p))
+}
+
+// WaitForSync waits until the clock is synchronized to the reference clock
+// like the network clock or the timeout expires.
+// Negative timeout waits infinitely.
+// It returns true if the clock is synchronized.
+func (c *Clock) WaitForSync(timeout time.Duration) bool {
+	return C.waitClockForSync(c.p, C.gint64(timeout)) != 0
+}
+
+// IsSynced returns true if the clock is synchronized to the reference clock.
+// Clocks without the synchronization are always synced.
+func (c *Clock) IsSynced() bool {
+	return C.isClockSynced(c.p) != 0
+}
+
+// Clock returns the clock used by the element.
+// Error is returned if the element has no clock, typically not in StatePlaying.
+func (s *Element) Clock() (*Clock, error) {
+	c := C.getElementClock(s.p)
+	if c == nil {
+		return nil, fmt.Errorf("Element has no clock")
+	}
+	return NewClock(unsafe.Pointer(c)), nil
+}
+
+// BaseTime returns the base time of the element.
+// Running time is calculated by subtracting the base time from the clock time.
+func (s *Element) BaseTime() time.Duration {
+	return time.Duration(C.getElementBaseTime(s.p))
+}
+
+// RunningTime returns the current running time of the element.
+// Error is returned if the element has no clock.
+func (s *Element) RunningTime() (time.Duration, error) {
+	c, err := s.Clock()
+	if err != nil {
+		return 0, err
+	}
+	return c.Time() - s.BaseTime(), nil
+}
+
+// Clock returns the clock which is used or will be used by the pipeline.
+func (p *Pipeline) Clock() (*Clock, error) {
+	c := C.getPipelineClock(p.UnsafePointer())
+	if c == nil {
+		return nil, fmt.Errorf("Pipeline has no clock")
+	}
+	return NewClock(unsafe.Pointer(c)), nil
+}
+
+// UseClock forces the pipeline to use the clock.
+// If c is nil, the pipeline uses no clock and runs as fast as possible.
+func (p *Pipeline) UseClock(c *Clock) {
+	var cp unsafe.Pointer
+	if c != nil {
+		cp = c.p
+	}
+	C.pipelineUseClock(p.UnsafePointer(), cp)
+}
+
+// AutoClock lets the pipeline select the clock automatically.
+func (p *Pipeline) AutoClock() {
+	C.pipelineAutoClock(p.UnsafePointer())
+}
@@ -0,0 +1,95 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gst
+
+import (
+	"testing"
+	"time"
+)
+
+func mustNewSystemClock(t *testing.T, typ ClockType) *Clock {
+	c, err := NewSystemClock(typ)
+	if err != nil {
+		t.Fatalf("Failed to create system clock: %v", err)
+	}
+	return c
+}
+
+func TestSystemClock(t *testing.T) {
+	for _, typ := range []ClockType{ClockTypeRealtime, ClockTypeMonotonic} {
+		c := mustNewSystemClock(t, typ)
+		t0 := c.Time()
+		time.Sleep(10 * time.Millisecond)
+		if d := c.Time() - t0; d < 10*time.Millisecond {
+			t.Errorf("Clock must advance at least 10ms, but advanced %v", d)
+		}
+		if !c.IsSynced() {
+			t.Error("System clock must be synced")
+		}
+	}
+	rt := mustNewSystemClock(t, ClockTypeRealtime).Time()
+	if d := time.Duration(time.Now().UnixNano()) - rt; d < -time.Second || time.Second < d {
+		t.Errorf("Realtime clock must be close to the wall clock, but differs by %v", d)
+	}
+	if _, err := NewSystemClock(ClockType(100)); err == nil {
+		t.Error("Creating system clock of unsupported type must fail")
+	}
+}
+
+func TestPipeline_clock(t *testing.T) {
+	p, err := NewPipeline("")
+	if err != nil {
+		t.Fatalf("Failed to create pipeline: %v", err)
+	}
+	es := mustNewElements(t, "fakesrc", "fakesink")
+	if err := p.Add(es...); err != nil {
+		t.Fatalf("Failed to add elements: %v", err)
+	}
+	if err := es[0].Link(es[1]); err != nil {
+		t.Fatalf("Failed to link elements: %v", err)
+	}
+
+	if _, err := p.RunningTime(); err == nil {
+		t.Error("RunningTime of stopped pipeline must fail")
+	}
+
+	c := mustNewSystemClock(t, ClockTypeMonotonic)
+	p.UseClock(c)
+	if _, err := p.SetState(StatePlaying); err != nil {
+		t.Fatalf("Failed to start pipeline: %v", err)
+	}
+	defer p.SetState(StateNull)
+	if _, _, _, err := p.GetState(time.Second); err != nil {
+		t.Fatalf("Failed to get state: %v", err)
+	}
+
+	pc, err := p.Clock()
+	if err != nil {
+		t.Fatalf("Failed to get clock: %v", err)
+	}
+	if pc.UnsafePointer() != c.UnsafePointer() {
+		t.Error("Pipeline must use the forced clock")
+	}
+	rt, err := p.RunningTime()
+	if err != nil {
+		t.Fatalf("Failed to get running time: %v", err)
+	}
+	if rt < 0 || time.Second < rt {
+		t.Errorf("Unexpected running time %v", rt)
+	}
+	if bt := p.BaseTime(); bt+rt > c.Time() {
+		t.Errorf("Base time %v plus running time %v must not exceed clock time", bt, rt)
+	}
+}
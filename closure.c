@@ -0,0 +1,65 @@
+/* Copyright 2026 SEQSENSE, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+#include <stdlib.h>
+#include <glib-object.h>
+#include <gst/gst.h>
+
+#include "closure.h"
+
+static void closureMarshal(
+    GClosure* closure, GValue* return_value, guint n_param_values,
+    const GValue* param_values, gpointer invocation_hint, gpointer marshal_data)
+{
+  goClosureMarshal(
+      GPOINTER_TO_INT(closure->data), return_value, n_param_values, (GValue*)param_values);
+}
+static void closureFinalize(gpointer data, GClosure* closure)
+{
+  goClosureFinalize(GPOINTER_TO_INT(data));
+}
+gulong connectClosure(void* instance, const char* signal, int id, gboolean after)
+{
+  guint signal_id;
+  GQuark detail;
+  if (!g_signal_parse_name(signal, G_OBJECT_TYPE(instance), &signal_id, &detail, TRUE))
+    return 0;
+
+  GClosure* closure = g_closure_new_simple(sizeof(GClosure), GINT_TO_POINTER(id));
+  g_closure_set_marshal(closure, closureMarshal);
+  g_closure_add_finalize_notifier(closure, GINT_TO_POINTER(id), closureFinalize);
+  return g_signal_connect_closure_by_id(instance, signal_id, detail, closure, after);
+}
+void disconnectClosure(void* instance, gulong handler_id)
+{
+  if (g_signal_handler_is_connected(instance, handler_id))
+    g_signal_handler_disconnect(instance, handler_id);
+}
+gboolean querySignal(void* instance, const char* name, GSignalQuery* query, GQuark* detail)
+{
+  guint signal_id;
+  if (!g_signal_parse_name(name, G_OBJECT_TYPE(instance), &signal_id, detail, TRUE))
+    return FALSE;
+  g_signal_query(signal_id, query);
+  return query->signal_id != 0;
+}
+GType signalParamType(GSignalQuery* query, guint i)
+{
+  return query->param_types[i] & ~G_SIGNAL_TYPE_STATIC_SCOPE;
+}
+GType signalReturnType(GSignalQuery* query)
+{
+  return query->return_type & ~G_SIGNAL_TYPE_STATIC_SCOPE;
+}
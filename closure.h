@@ -0,0 +1,32 @@
+/* Copyright 2026 SEQSENSE, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+#ifndef CLOSURE_H
+#define CLOSURE_H
+
+#include <stdlib.h>
+#include <glib-object.h>
+#include <gst/gst.h>
+
+extern void goClosureMarshal(int id, GValue* ret, guint n_params, GValue* params);
+extern void goClosureFinalize(int id);
+
+gulong connectClosure(void* instance, const char* signal, int id, gboolean after);
+void disconnectClosure(void* instance, gulong handler_id);
+gboolean querySignal(void* instance, const char* name, GSignalQuery* query, GQuark* detail);
+GType signalParamType(GSignalQuery* query, guint i);
+GType signalReturnType(GSignalQuery* query);
+
+#endif  // CLOSURE_H
@@ -22,55 +22,32 @@ package gst
 // {
 //   gst_object_unref(element);
 // }
-// GstState getElementState(void* element)
+// const char* getElementName(void* element)
 // {
-//   return GST_STATE(element);
+//   return GST_OBJECT_NAME(element);
 // }
-// void setElementState(void* element, int state)
-// {
-//   gst_element_set_state(element, state);
-// }
-// GValue* newGValue()
-// {
-//   GValue* value = malloc(sizeof(GValue));
-//   GValue init = G_VALUE_INIT;
-//   *value = init;
-//   return value;
-// }
-// void freeGValue(GValue* value)
-// {
-//   g_value_unset(value);
-//   free(value);
-// }
-// GValue* getProperty(void* element, const char* name)
+// GstState getElementState(void* element)
 // {
-//   g_object_ref(element);
-//   GParamSpec* pspec = g_object_class_find_property(G_OBJECT_GET_CLASS(element), name);
-//   if (pspec == NULL)
-//   {
-//     g_object_unref(element);
-//     return NULL;
-//   }
-//   GValue* value = newGValue();
-//   g_value_init(value, G_PARAM_SPEC_VALUE_TYPE(pspec));
-//   g_object_get_property(element, name, value);
-//   g_object_unref(element);
-//   return value;
+//   return GST_STATE(element);
 // }
-// void setProperty(void* element, const char* name, GValue* value)
+// GstStateChangeReturn setElementState(void* element, int state)
 // {
-//   g_object_set_property(element, name, value);
+//   return gst_element_set_state(element, state);
 // }
-// GType getValueType(GValue* value)
+// GstStateChangeReturn getElementFullState(void* element, GstState* state, GstState* pending, gint64 timeout)
 // {
-//   return G_VALUE_TYPE(value);
+//   GstClockTime t = GST_CLOCK_TIME_NONE;
+//   if (timeout >= 0)
+//     t = timeout;
+//   return gst_element_get_state(element, state, pending, t);
 // }
 import "C"
 
 import (
+	"errors"
 	"fmt"
-	"reflect"
 	"runtime"
+	"time"
 	"unsafe"
 )
 
@@ -113,6 +90,40 @@ func (s State) String() string {
 	}
 }
 
+// StateChangeReturn is a result of the state change.
+type StateChangeReturn uint8
+
+const (
+	// StateChangeFailure states that the state change failed.
+	StateChangeFailure StateChangeReturn = iota
+	// StateChangeSuccess states that the state change succeeded.
+	StateChangeSuccess
+	// StateChangeAsync states that the state change will happen asynchronously.
+	StateChangeAsync
+	// StateChangeNoPreroll states that the state change succeeded but the element
+	// cannot produce data in StatePaused. This typically happens with live sources.
+	StateChangeNoPreroll
+)
+
+// String returns string representation of the StateChangeReturn.
+func (r StateChangeReturn) String() string {
+	switch r {
+	case StateChangeFailure:
+		return "StateChangeFailure"
+	case StateChangeSuccess:
+		return "StateChangeSuccess"
+	case StateChangeAsync:
+		return "StateChangeAsync"
+	case StateChangeNoPreroll:
+		return "StateChangeNoPreroll"
+	default:
+		return fmt.Sprintf("Unknown StateChangeReturn (%d)", int(r))
+	}
+}
+
+// ErrStateChangeFailure is returned if the state change failed.
+var ErrStateChangeFailure = errors.New("State change failed")
+
 // NewElement creates a new GStreamer element wrapper from given raw pointer.
 func NewElement(p unsafe.Pointer) *Element {
 	e := &Element{p: p}
@@ -120,6 +131,12 @@ func NewElement(p unsafe.Pointer) *Element {
 	return e
 }
 
+// newElementRef creates a new GStreamer element wrapper with a new reference.
+func newElementRef(p unsafe.Pointer) *Element {
+	C.gst_object_ref(C.gpointer(p))
+	return NewElement(p)
+}
+
 func finalizeElement(s *Element) {
 	C.unrefElement(s.UnsafePointer())
 }
@@ -129,62 +146,59 @@ func (s *Element) UnsafePointer() unsafe.Pointer {
 	return s.p
 }
 
+// Name returns the name of the element.
+func (s *Element) Name() string {
+	return C.GoString(C.getElementName(s.p))
+}
+
 // State returns the current state of the element.
 func (s *Element) State() State {
 	return State(C.getElementState(s.p))
 }
 
 // SetState changes the current state of the element.
-func (s *Element) SetState(st State) {
-	C.setElementState(s.p, C.int(st))
+// ErrStateChangeFailure is returned if the element failed to change the state.
+func (s *Element) SetState(st State) (StateChangeReturn, error) {
+	ret := StateChangeReturn(C.setElementState(s.p, C.int(st)))
+	if ret == StateChangeFailure {
+		return ret, ErrStateChangeFailure
+	}
+	return ret, nil
+}
+
+// GetState waits until the asynchronous state change is completed or the timeout expires
+// and returns the current and pending states.
+// Negative timeout waits infinitely and zero timeout returns immediately.
+// StateChangeAsync is returned if the state change is still in progress, and
+// StateChangeNoPreroll is returned if the element is live.
+// ErrStateChangeFailure is returned if the last state change failed.
+func (s *Element) GetState(timeout time.Duration) (State, State, StateChangeReturn, error) {
+	var cur, pending C.GstState
+	ret := StateChangeReturn(C.getElementFullState(s.p, &cur, &pending, C.gint64(timeout)))
+	if ret == StateChangeFailure {
+		return State(cur), State(pending), ret, ErrStateChangeFailure
+	}
+	return State(cur), State(pending), ret, nil
 }
 
 // GetProperty returns property of the element.
+// Fundamental scalar types are returned as bool, int8, uint8, int, uint,
+// int64, uint64, float32, float64 and string.
+// GEnum and GFlags types are returned as EnumValue and FlagsValue.
+// GstElement, GstPad and other GObject types are returned as *Element, *Pad and *Object.
+// GstCaps and GstStructure are returned as *Caps and *Structure, and
+// fractions, ranges, lists and arrays are returned as Fraction, IntRange,
+// DoubleRange, FractionRange, ValueList and ValueArray.
+// GstDateTime and GDate are returned as time.Time.
 func (s *Element) GetProperty(name string) (interface{}, error) {
-	cName := C.CString(name)
-	defer C.free(unsafe.Pointer(cName))
-	v := C.getProperty(s.UnsafePointer(), cName)
-	if v == nil {
-		return nil, fmt.Errorf("Property not found")
-	}
-	defer C.freeGValue(v)
-
-	t := C.getValueType(v)
-	switch t {
-	case C.G_TYPE_INT:
-		return int(C.g_value_get_int(v)), nil
-	case C.G_TYPE_UINT:
-		return uint(C.g_value_get_uint(v)), nil
-	case C.G_TYPE_STRING:
-		return C.GoString(C.g_value_get_string(v)), nil
-	default:
-		return nil, fmt.Errorf("Unsupported GValue type %d", t)
-	}
+	return getObjectProperty(s.UnsafePointer(), name)
 }
 
 // SetProperty sets property of the element.
+// Numeric values are converted to the type of the property if it fits in the range.
+// GEnum and GFlags typed properties also accept the nick or name string.
+// Multiple flags can be joined by "+" like "video+audio".
+// GstCaps and GstStructure typed properties also accept the string representation.
 func (s *Element) SetProperty(name string, val interface{}) error {
-	v := C.newGValue()
-	defer C.freeGValue(v)
-
-	switch val := val.(type) {
-	case int:
-		C.g_value_init(v, C.G_TYPE_INT)
-		C.g_value_set_int(v, C.int(val))
-	case uint:
-		C.g_value_init(v, C.G_TYPE_UINT)
-		C.g_value_set_uint(v, C.uint(val))
-	case string:
-		cValue := C.CString(val)
-		defer C.free(unsafe.Pointer(cValue))
-		C.g_value_init(v, C.G_TYPE_STRING)
-		C.g_value_set_string(v, cValue)
-	default:
-		return fmt.Errorf("Unsupported GValue type %d", reflect.TypeOf(val).Kind())
-	}
-
-	cName := C.CString(name)
-	defer C.free(unsafe.Pointer(cName))
-	C.setProperty(s.UnsafePointer(), cName, v)
-	return nil
+	return setObjectProperty(s.UnsafePointer(), name, val)
 }
@@ -17,6 +17,7 @@ package gst
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/seqsense/sq-gst-go/internal/dummyelement"
 )
@@ -84,3 +85,255 @@ func TestSetProperty_String(t *testing.T) {
 		t.Fatalf("Wrong return value type: %s", reflect.TypeOf(v).Kind())
 	}
 }
+
+func TestGetProperty_Scalar(t *testing.T) {
+	testCases := map[string]struct {
+		factory  string
+		name     string
+		expected interface{}
+	}{
+		"Bool": {
+			factory: "fakesink", name: "sync", expected: false,
+		},
+		"Int64": {
+			factory: "fakesink", name: "ts-offset", expected: int64(0),
+		},
+		"Uint64": {
+			factory: "queue", name: "max-size-time", expected: uint64(1000000000),
+		},
+		"Float32": {
+			factory: "identity", name: "drop-probability", expected: float32(0),
+		},
+		"Float64": {
+			factory: "volume", name: "volume", expected: float64(1),
+		},
+	}
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			e := NewElement(dummyelement.NewWithFactory(tt.factory))
+			p, err := e.GetProperty(tt.name)
+			if err != nil {
+				t.Fatalf("Failed to GetProperty: %v", err)
+			}
+			if !reflect.DeepEqual(tt.expected, p) {
+				t.Errorf("%s.%s must be %v (%T), but got %v (%T)",
+					tt.factory, tt.name, tt.expected, tt.expected, p, p)
+			}
+		})
+	}
+}
+
+func TestSetProperty_Scalar(t *testing.T) {
+	testCases := map[string]struct {
+		factory  string
+		name     string
+		value    interface{}
+		expected interface{}
+	}{
+		"Bool": {
+			factory: "fakesink", name: "sync", value: true, expected: true,
+		},
+		"Int64": {
+			factory: "fakesink", name: "ts-offset", value: int64(-5), expected: int64(-5),
+		},
+		"Int64FromInt": {
+			factory: "fakesink", name: "ts-offset", value: 10, expected: int64(10),
+		},
+		"Uint64": {
+			factory: "queue", name: "max-size-time", value: uint64(3000000000), expected: uint64(3000000000),
+		},
+		"Float32": {
+			factory: "identity", name: "drop-probability", value: float32(0.5), expected: float32(0.5),
+		},
+		"Float64": {
+			factory: "volume", name: "volume", value: 0.25, expected: 0.25,
+		},
+		"Float64FromInt": {
+			factory: "volume", name: "volume", value: 2, expected: float64(2),
+		},
+	}
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			e := NewElement(dummyelement.NewWithFactory(tt.factory))
+			if err := e.SetProperty(tt.name, tt.value); err != nil {
+				t.Fatalf("Failed to SetProperty: %v", err)
+			}
+			p, err := e.GetProperty(tt.name)
+			if err != nil {
+				t.Fatalf("Failed to GetProperty: %v", err)
+			}
+			if !reflect.DeepEqual(tt.expected, p) {
+				t.Errorf("%s.%s must be %v (%T), but got %v (%T)",
+					tt.factory, tt.name, tt.expected, tt.expected, p, p)
+			}
+		})
+	}
+}
+
+func TestSetProperty_Error(t *testing.T) {
+	testCases := map[string]struct {
+		name  string
+		value interface{}
+	}{
+		"NotFound":     {name: "inexistent-property", value: 1},
+		"WrongType":    {name: "sync", value: "true"},
+		"Overflow":     {name: "num-buffers", value: int64(1) << 40},
+		"NegativeUint": {name: "blocksize", value: -1},
+	}
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			e := NewElement(dummyelement.New())
+			if err := e.SetProperty(tt.name, tt.value); err == nil {
+				t.Errorf("SetProperty(%s, %v) must fail", tt.name, tt.value)
+			}
+		})
+	}
+}
+
+func TestGetProperty_Enum(t *testing.T) {
+	e := NewElement(dummyelement.NewWithFactory("queue"))
+	p, err := e.GetProperty("leaky")
+	if err != nil {
+		t.Fatalf("Failed to GetProperty: %v", err)
+	}
+	switch v := p.(type) {
+	case EnumValue:
+		if v.Value != 0 || v.Nick != "no" {
+			t.Errorf("queue.leaky must be 0 (no), but got %d (%s)", v.Value, v.Nick)
+		}
+	default:
+		t.Fatalf("Wrong return value type: %T", v)
+	}
+}
+
+func TestSetProperty_Enum(t *testing.T) {
+	testCases := map[string]struct {
+		value    interface{}
+		expected int
+	}{
+		"Int":       {value: 1, expected: 1},
+		"Nick":      {value: "downstream", expected: 2},
+		"EnumValue": {value: EnumValue{Value: 1}, expected: 1},
+	}
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			e := NewElement(dummyelement.NewWithFactory("queue"))
+			if err := e.SetProperty("leaky", tt.value); err != nil {
+				t.Fatalf("Failed to SetProperty: %v", err)
+			}
+			p, err := e.GetProperty("leaky")
+			if err != nil {
+				t.Fatalf("Failed to GetProperty: %v", err)
+			}
+			if v := p.(EnumValue).Value; v != tt.expected {
+				t.Errorf("queue.leaky must be %d, but got %d", tt.expected, v)
+			}
+		})
+	}
+
+	e := NewElement(dummyelement.NewWithFactory("queue"))
+	for _, v := range []interface{}{5, "inexistent"} {
+		if err := e.SetProperty("leaky", v); err == nil {
+			t.Errorf("SetProperty(leaky, %v) must fail", v)
+		}
+	}
+}
+
+func TestSetProperty_Flags(t *testing.T) {
+	testCases := map[string]struct {
+		value interface{}
+	}{
+		"Uint":       {value: uint(3)},
+		"Nick":       {value: "video+audio"},
+		"FlagsValue": {value: FlagsValue{Value: 3}},
+	}
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			e := NewElement(dummyelement.NewWithFactory("playbin"))
+			if err := e.SetProperty("flags", tt.value); err != nil {
+				t.Fatalf("Failed to SetProperty: %v", err)
+			}
+			p, err := e.GetProperty("flags")
+			if err != nil {
+				t.Fatalf("Failed to GetProperty: %v", err)
+			}
+			switch v := p.(type) {
+			case FlagsValue:
+				if v.Value != 3 || v.Nick != "video+audio" {
+					t.Errorf("playbin.flags must be 3 (video+audio), but got %d (%s)", v.Value, v.Nick)
+				}
+			default:
+				t.Fatalf("Wrong return value type: %T", v)
+			}
+		})
+	}
+
+	e := NewElement(dummyelement.NewWithFactory("playbin"))
+	if err := e.SetProperty("flags", "video+inexistent"); err == nil {
+		t.Error("SetProperty with unknown flag must fail")
+	}
+}
+
+func TestSetState(t *testing.T) {
+	t.Run("Async", func(t *testing.T) {
+		e := NewElement(dummyelement.New())
+		defer e.SetState(StateNull)
+
+		ret, err := e.SetState(StatePaused)
+		if err != nil {
+			t.Fatalf("Failed to SetState: %v", err)
+		}
+		if ret != StateChangeAsync {
+			t.Errorf("Sink must change the state asynchronously, but got %s", ret)
+		}
+		cur, pending, ret, err := e.GetState(0)
+		if err != nil {
+			t.Fatalf("Failed to GetState: %v", err)
+		}
+		if cur != StateReady || pending != StatePaused || ret != StateChangeAsync {
+			t.Errorf("Unexpected state %s, pending %s, %s", cur, pending, ret)
+		}
+	})
+	t.Run("NoPreroll", func(t *testing.T) {
+		e := NewElement(dummyelement.NewWithFactory("fakesrc"))
+		defer e.SetState(StateNull)
+		if err := e.SetProperty("is-live", true); err != nil {
+			t.Fatalf("Failed to SetProperty: %v", err)
+		}
+
+		ret, err := e.SetState(StatePaused)
+		if err != nil {
+			t.Fatalf("Failed to SetState: %v", err)
+		}
+		if ret != StateChangeNoPreroll {
+			t.Errorf("Live source must return StateChangeNoPreroll, but got %s", ret)
+		}
+		cur, _, ret, err := e.GetState(time.Second)
+		if err != nil {
+			t.Fatalf("Failed to GetState: %v", err)
+		}
+		if cur != StatePaused || ret != StateChangeNoPreroll {
+			t.Errorf("Unexpected state %s, %s", cur, ret)
+		}
+	})
+	t.Run("Failure", func(t *testing.T) {
+		e := NewElement(dummyelement.NewWithFactory("filesrc"))
+		defer e.SetState(StateNull)
+
+		ret, err := e.SetState(StatePaused)
+		if err != ErrStateChangeFailure {
+			t.Errorf("filesrc without location must fail to start, but got %v", err)
+		}
+		if ret != StateChangeFailure {
+			t.Errorf("Expected StateChangeFailure, but got %s", ret)
+		}
+		if _, _, _, err := e.GetState(0); err != ErrStateChangeFailure {
+			t.Errorf("GetState after failure must return ErrStateChangeFailure, but got %v", err)
+		}
+	})
+}
@@ -0,0 +1,157 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gst
+
+// #cgo pkg-config: gobject-2.0 gstreamer-1.0
+// #include "gvalue.h"
+import "C"
+
+import (
+	"fmt"
+	"strings"
+	"unsafe"
+)
+
+// EnumValue is a value of GEnum typed property.
+type EnumValue struct {
+	Value int
+	Name  string
+	Nick  string
+}
+
+// String returns the nick of the value.
+func (v EnumValue) String() string {
+	return v.Nick
+}
+
+// FlagsValue is a value of GFlags typed property.
+// Name and Nick are the names of the set bits joined by " | " and "+" respectively.
+type FlagsValue struct {
+	Value uint
+	Name  string
+	Nick  string
+}
+
+// String returns the nick of the value.
+func (v FlagsValue) String() string {
+	return v.Nick
+}
+
+func goEnumValue(t C.GType, val C.gint) EnumValue {
+	klass := (*C.GEnumClass)(C.g_type_class_ref(t))
+	defer C.g_type_class_unref(C.gpointer(klass))
+
+	ev := EnumValue{Value: int(val)}
+	if e := C.g_enum_get_value(klass, val); e != nil {
+		ev.Name = C.GoString(e.value_name)
+		ev.Nick = C.GoString(e.value_nick)
+	}
+	return ev
+}
+
+func goFlagsValue(t C.GType, val C.guint) FlagsValue {
+	klass := (*C.GFlagsClass)(C.g_type_class_ref(t))
+	defer C.g_type_class_unref(C.gpointer(klass))
+
+	var names, nicks []string
+	rest := val
+	for {
+		f := C.g_flags_get_first_value(klass, rest)
+		if f == nil {
+			break
+		}
+		names = append(names, C.GoString(f.value_name))
+		nicks = append(nicks, C.GoString(f.value_nick))
+		if f.value == 0 {
+			break
+		}
+		rest &^= f.value
+		if rest == 0 {
+			break
+		}
+	}
+	return FlagsValue{
+		Value: uint(val),
+		Name:  strings.Join(names, " | "),
+		Nick:  strings.Join(nicks, "+"),
+	}
+}
+
+func enumValueOf(val interface{}, t C.GType) (C.gint, error) {
+	klass := (*C.GEnumClass)(C.g_type_class_ref(t))
+	defer C.g_type_class_unref(C.gpointer(klass))
+
+	switch val := val.(type) {
+	case string:
+		cStr := C.CString(val)
+		defer C.free(unsafe.Pointer(cStr))
+		if e := C.g_enum_get_value_by_nick(klass, cStr); e != nil {
+			return e.value, nil
+		}
+		if e := C.g_enum_get_value_by_name(klass, cStr); e != nil {
+			return e.value, nil
+		}
+		return 0, fmt.Errorf("Invalid value \"%s\" for enum type %s", val, typeName(t))
+	case EnumValue:
+		return enumValueOf(val.Value, t)
+	default:
+		i, err := toInt64(val, t, -1<<31, 1<<31-1)
+		if err != nil {
+			return 0, err
+		}
+		if C.g_enum_get_value(klass, C.gint(i)) == nil {
+			return 0, fmt.Errorf("Invalid value %d for enum type %s", i, typeName(t))
+		}
+		return C.gint(i), nil
+	}
+}
+
+func flagsValueOf(val interface{}, t C.GType) (C.guint, error) {
+	klass := (*C.GFlagsClass)(C.g_type_class_ref(t))
+	defer C.g_type_class_unref(C.gpointer(klass))
+
+	switch val := val.(type) {
+	case string:
+		var ret C.guint
+		for _, name := range strings.FieldsFunc(val, func(r rune) bool { return r == '+' || r == '|' }) {
+			name = strings.TrimSpace(name)
+			if name == "" {
+				continue
+			}
+			cStr := C.CString(name)
+			f := C.g_flags_get_value_by_nick(klass, cStr)
+			if f == nil {
+				f = C.g_flags_get_value_by_name(klass, cStr)
+			}
+			C.free(unsafe.Pointer(cStr))
+			if f == nil {
+				return 0, fmt.Errorf("Invalid value \"%s\" for flags type %s", name, typeName(t))
+			}
+			ret |= f.value
+		}
+		return ret, nil
+	case FlagsValue:
+		return flagsValueOf(val.Value, t)
+	default:
+		u, err := toUint64(val, t, 1<<32-1)
+		if err != nil {
+			return 0, err
+		}
+		if C.guint(u)&^klass.mask != 0 {
+			return 0, fmt.Errorf("Invalid value 0x%x for flags type %s", u, typeName(t))
+		}
+		return C.guint(u), nil
+	}
+}
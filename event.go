@@ -0,0 +1,272 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gst
+
+// #cgo pkg-config: gobject-2.0 gstreamer-1.0
+// #include <gst/gst.h>
+// GstEventType getEventType(GstEvent* event)
+// {
+//   return GST_EVENT_TYPE(event);
+// }
+// void refEvent(GstEvent* event)
+// {
+//   gst_mini_object_ref(GST_MINI_OBJECT_CAST(event));
+// }
+// void unrefEvent(GstEvent* event)
+// {
+//   gst_mini_object_unref(GST_MINI_OBJECT_CAST(event));
+// }
+// gboolean sendElementEvent(void* element, GstEvent* event)
+// {
+//   refEvent(event);
+//   return gst_element_send_event(element, event);
+// }
+// gboolean sendPadEvent(void* pad, GstEvent* event)
+// {
+//   refEvent(event);
+//   return gst_pad_send_event(pad, event);
+// }
+// gboolean pushPadEvent(void* pad, GstEvent* event)
+// {
+//   refEvent(event);
+//   return gst_pad_push_event(pad, event);
+// }
+// static GstClockTime toClockTime(gint64 t)
+// {
+//   if (t < 0)
+//     return GST_CLOCK_TIME_NONE;
+//   return t;
+// }
+// GstEvent* newUpstreamForceKeyUnitEvent(gint64 running_time, gboolean all_headers, guint count)
+// {
+//   // Same as gst_video_event_new_upstream_force_key_unit without depending on gstreamer-video.
+//   GstStructure* s = gst_structure_new(
+//       "GstForceKeyUnit",
+//       "running-time", GST_TYPE_CLOCK_TIME, toClockTime(running_time),
+//       "all-headers", G_TYPE_BOOLEAN, all_headers,
+//       "count", G_TYPE_UINT, count,
+//       NULL);
+//   return gst_event_new_custom(GST_EVENT_CUSTOM_UPSTREAM, s);
+// }
+// GstEvent* newDownstreamForceKeyUnitEvent(
+//     gint64 timestamp, gint64 stream_time, gint64 running_time, gboolean all_headers, guint count)
+// {
+//   // Same as gst_video_event_new_downstream_force_key_unit without depending on gstreamer-video.
+//   GstStructure* s = gst_structure_new(
+//       "GstForceKeyUnit",
+//       "timestamp", GST_TYPE_CLOCK_TIME, toClockTime(timestamp),
+//       "stream-time", GST_TYPE_CLOCK_TIME, toClockTime(stream_time),
+//       "running-time", GST_TYPE_CLOCK_TIME, toClockTime(running_time),
+//       "all-headers", G_TYPE_BOOLEAN, all_headers,
+//       "count", G_TYPE_UINT, count,
+//       NULL);
+//   return gst_event_new_custom(GST_EVENT_CUSTOM_DOWNSTREAM, s);
+// }
+import "C"
+
+import (
+	"fmt"
+	"runtime"
+	"time"
+	"unsafe"
+)
+
+// EventType is a type of GstEvent.
+type EventType uint
+
+const (
+	// EventUnknown is an unknown event type.
+	EventUnknown EventType = C.GST_EVENT_UNKNOWN
+	// EventFlushStart starts a flush operation and discards all data.
+	EventFlushStart EventType = C.GST_EVENT_FLUSH_START
+	// EventFlushStop stops a flush operation.
+	EventFlushStop EventType = C.GST_EVENT_FLUSH_STOP
+	// EventStreamStart marks the start of a new stream.
+	EventStreamStart EventType = C.GST_EVENT_STREAM_START
+	// EventCaps notifies the caps of the following buffers.
+	EventCaps EventType = C.GST_EVENT_CAPS
+	// EventSegment notifies the segment of the following buffers.
+	EventSegment EventType = C.GST_EVENT_SEGMENT
+	// EventTag carries the tags found in the stream.
+	EventTag EventType = C.GST_EVENT_TAG
+	// EventBufferSize notifies the buffer size for the following buffers.
+	EventBufferSize EventType = C.GST_EVENT_BUFFERSIZE
+	// EventSinkMessage carries a message to be posted by the sink.
+	EventSinkMessage EventType = C.GST_EVENT_SINK_MESSAGE
+	// EventEOS marks the end of the stream.
+	EventEOS EventType = C.GST_EVENT_EOS
+	// EventTOC carries the table of contents of the stream.
+	EventTOC EventType = C.GST_EVENT_TOC
+	// EventProtection carries the protection information of the stream.
+	EventProtection EventType = C.GST_EVENT_PROTECTION
+	// EventSegmentDone marks the end of a segment playback.
+	EventSegmentDone EventType = C.GST_EVENT_SEGMENT_DONE
+	// EventGap marks a gap in the stream with no data.
+	EventGap EventType = C.GST_EVENT_GAP
+	// EventQOS notifies the quality of service from the sink.
+	EventQOS EventType = C.GST_EVENT_QOS
+	// EventSeek requests a new playback position.
+	EventSeek EventType = C.GST_EVENT_SEEK
+	// EventNavigation carries a user navigation action.
+	EventNavigation EventType = C.GST_EVENT_NAVIGATION
+	// EventLatency notifies the latency configured by the pipeline.
+	EventLatency EventType = C.GST_EVENT_LATENCY
+	// EventStep requests a step operation.
+	EventStep EventType = C.GST_EVENT_STEP
+	// EventReconfigure requests the upstream elements to renegotiate.
+	EventReconfigure EventType = C.GST_EVENT_RECONFIGURE
+	// EventTOCSelect selects an entry of the table of contents.
+	EventTOCSelect EventType = C.GST_EVENT_TOC_SELECT
+	// EventCustomUpstream is an application specific upstream event.
+	EventCustomUpstream EventType = C.GST_EVENT_CUSTOM_UPSTREAM
+	// EventCustomDownstream is an application specific downstream event serialized with the data.
+	EventCustomDownstream EventType = C.GST_EVENT_CUSTOM_DOWNSTREAM
+	// EventCustomDownstreamOOB is an application specific downstream event not serialized with the data.
+	EventCustomDownstreamOOB EventType = C.GST_EVENT_CUSTOM_DOWNSTREAM_OOB
+	// EventCustomDownstreamSticky is an application specific downstream event stored on the pad.
+	EventCustomDownstreamSticky EventType = C.GST_EVENT_CUSTOM_DOWNSTREAM_STICKY
+	// EventCustomBoth is an application specific event in both directions serialized with the data.
+	EventCustomBoth EventType = C.GST_EVENT_CUSTOM_BOTH
+	// EventCustomBothOOB is an application specific event in both directions not serialized with the data.
+	EventCustomBothOOB EventType = C.GST_EVENT_CUSTOM_BOTH_OOB
+)
+
+// String returns the name of the EventType.
+func (t EventType) String() string {
+	return C.GoString(C.gst_event_type_get_name(C.GstEventType(t)))
+}
+
+func (t EventType) isCustom() bool {
+	switch t {
+	case EventCustomUpstream, EventCustomDownstream, EventCustomDownstreamOOB,
+		EventCustomDownstreamSticky, EventCustomBoth, EventCustomBothOOB:
+		return true
+	}
+	return false
+}
+
+// Event is a wrapper of GstEvent.
+type Event struct {
+	p *C.GstEvent
+}
+
+// newEvent creates an event wrapper which takes the ownership.
+func newEvent(p *C.GstEvent) *Event {
+	e := &Event{p: p}
+	runtime.SetFinalizer(e, finalizeEvent)
+	return e
+}
+
+func newEventRef(p *C.GstEvent) *Event {
+	C.refEvent(p)
+	return newEvent(p)
+}
+
+func finalizeEvent(e *Event) {
+	C.unrefEvent(e.p)
+}
+
+// UnsafePointer returns the raw pointer of the event.
+func (e *Event) UnsafePointer() unsafe.Pointer {
+	return unsafe.Pointer(e.p)
+}
+
+// Type returns the type of the event.
+func (e *Event) Type() EventType {
+	return EventType(C.getEventType(e.p))
+}
+
+// Structure returns a copy of the structure of the event.
+// Error is returned if the event has no structure.
+func (e *Event) Structure() (*Structure, error) {
+	s := C.gst_event_get_structure(e.p)
+	if s == nil {
+		return nil, fmt.Errorf("Event %s has no structure", e.Type())
+	}
+	return newStructure(C.gst_structure_copy(s)), nil
+}
+
+// NewEOSEvent creates a new end-of-stream event.
+func NewEOSEvent() *Event {
+	return newEvent(C.gst_event_new_eos())
+}
+
+// NewFlushStartEvent creates a new flush-start event.
+func NewFlushStartEvent() *Event {
+	return newEvent(C.gst_event_new_flush_start())
+}
+
+// NewFlushStopEvent creates a new flush-stop event.
+// If resetTime is true, the running time is reset to zero.
+func NewFlushStopEvent(resetTime bool) *Event {
+	return newEvent(C.gst_event_new_flush_stop(gboolean(resetTime)))
+}
+
+// NewReconfigureEvent creates a new reconfigure event to request
+// upstream elements to renegotiate caps and reallocate buffers.
+func NewReconfigureEvent() *Event {
+	return newEvent(C.gst_event_new_reconfigure())
+}
+
+// NewUpstreamForceKeyUnitEvent creates a new upstream event to request an encoder
+// to produce a key unit.
+// Negative runningTime requests the key unit as soon as possible.
+// If allHeaders is true, the encoder also produces the stream headers.
+// count is the number of the requested key units so far.
+func NewUpstreamForceKeyUnitEvent(runningTime time.Duration, allHeaders bool, count uint) *Event {
+	return newEvent(C.newUpstreamForceKeyUnitEvent(C.gint64(runningTime), gboolean(allHeaders), C.guint(count)))
+}
+
+// NewDownstreamForceKeyUnitEvent creates a new downstream event to notify
+// that the key unit is produced.
+// Negative times are treated as unknown.
+func NewDownstreamForceKeyUnitEvent(timestamp, streamTime, runningTime time.Duration, allHeaders bool, count uint) *Event {
+	return newEvent(C.newDownstreamForceKeyUnitEvent(
+		C.gint64(timestamp), C.gint64(streamTime), C.gint64(runningTime), gboolean(allHeaders), C.guint(count),
+	))
+}
+
+// NewCustomEvent creates a new custom event carrying a copy of the structure.
+// t must be one of the custom event types like EventCustomDownstream.
+func NewCustomEvent(t EventType, s *Structure) (*Event, error) {
+	if !t.isCustom() {
+		return nil, fmt.Errorf("Event type %s is not a custom event type", t)
+	}
+	return newEvent(C.gst_event_new_custom(C.GstEventType(t), C.gst_structure_copy(s.p))), nil
+}
+
+// SendEvent sends the event to the element.
+// Upstream events are sent to the sink pads and downstream events are sent to the source pads.
+// For the pipeline, downstream events like EOS are sent to all source elements.
+// It returns true if the event is handled.
+func (s *Element) SendEvent(e *Event) bool {
+	return C.sendElementEvent(s.p, e.p) != 0
+}
+
+// SendEvent sends the event to the pad as if it was sent from the peer pad.
+// Downstream events should be sent to the sink pad and upstream events
+// should be sent to the source pad.
+// It returns true if the event is handled.
+func (p *Pad) SendEvent(e *Event) bool {
+	return C.sendPadEvent(p.p, e.p) != 0
+}
+
+// PushEvent pushes the event to the peer of the pad.
+// Downstream events should be pushed from the source pad and upstream events
+// should be pushed from the sink pad.
+// It returns true if the event is handled.
+func (p *Pad) PushEvent(e *Event) bool {
+	return C.pushPadEvent(p.p, e.p) != 0
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gst
+
+import (
+	"testing"
+)
+
+func TestNewEvent(t *testing.T) {
+	testCases := map[string]struct {
+		event *Event
+		typ   EventType
+	}{
+		"EOS":             {NewEOSEvent(), EventEOS},
+		"FlushStart":      {NewFlushStartEvent(), EventFlushStart},
+		"FlushStop":       {NewFlushStopEvent(true), EventFlushStop},
+		"Reconfigure":     {NewReconfigureEvent(), EventReconfigure},
+		"UpstreamKeyUnit": {NewUpstreamForceKeyUnitEvent(-1, true, 1), EventCustomUpstream},
+		"DownstreamKeyUnit": {
+			NewDownstreamForceKeyUnitEvent(-1, -1, -1, false, 1), EventCustomDownstream,
+		},
+	}
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			if typ := tt.event.Type(); typ != tt.typ {
+				t.Errorf("Expected event type %s, got %s", tt.typ, typ)
+			}
+		})
+	}
+
+	s, err := NewUpstreamForceKeyUnitEvent(-1, true, 1).Structure()
+	if err != nil {
+		t.Fatalf("Failed to get structure: %v", err)
+	}
+	if n := s.Name(); n != "GstForceKeyUnit" {
+		t.Errorf("Expected structure name \"GstForceKeyUnit\", got %s", n)
+	}
+	if v, err := s.Get("all-headers"); err != nil || v != true {
+		t.Errorf("Expected all-headers=true, got %v (%v)", v, err)
+	}
+	if v, err := s.Get("count"); err != nil || v != uint(1) {
+		t.Errorf("Expected count=1, got %v (%v)", v, err)
+	}
+	if v, err := s.Get("running-time"); err != nil || v != ^uint64(0) {
+		t.Errorf("Expected running-time=GST_CLOCK_TIME_NONE, got %v (%v)", v, err)
+	}
+}
+
+func TestNewCustomEvent(t *testing.T) {
+	s, err := NewStructure("custom")
+	if err != nil {
+		t.Fatalf("Failed to create structure: %v", err)
+	}
+	if err := s.Set("value", 1); err != nil {
+		t.Fatalf("Failed to set field: %v", err)
+	}
+	if _, err := NewCustomEvent(EventEOS, s); err == nil {
+		t.Error("Creating custom event with non-custom type must fail")
+	}
+	e, err := NewCustomEvent(EventCustomDownstream, s)
+	if err != nil {
+		t.Fatalf("Failed to create custom event: %v", err)
+	}
+	es, err := e.Structure()
+	if err != nil {
+		t.Fatalf("Failed to get structure: %v", err)
+	}
+	if v, err := es.Get("value"); err != nil || v != 1 {
+		t.Errorf("Expected value=1, got %v (err: %v)", v, err)
+	}
+}
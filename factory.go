@@ -0,0 +1,224 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gst
+
+// #cgo pkg-config: gobject-2.0 gstreamer-1.0
+// #include <stdlib.h>
+// #include <gst/gst.h>
+// GstElement* makeElement(const char* factory, const char* name)
+// {
+//   GstElement* e = gst_element_factory_make(factory, name);
+//   if (e != NULL)
+//     gst_object_ref_sink(e);
+//   return e;
+// }
+// GstElement* createElement(void* factory, const char* name)
+// {
+//   GstElement* e = gst_element_factory_create(factory, name);
+//   if (e != NULL)
+//     gst_object_ref_sink(e);
+//   return e;
+// }
+// GstElementFactory* getElementFactory(void* element)
+// {
+//   GstElementFactory* f = gst_element_get_factory(element);
+//   if (f != NULL)
+//     gst_object_ref(f);
+//   return f;
+// }
+// const char* getFeatureName(void* feature)
+// {
+//   return GST_OBJECT_NAME(feature);
+// }
+// const char* getFactoryMetadata(void* factory, const char* key)
+// {
+//   return gst_element_factory_get_metadata(factory, key);
+// }
+// GstStaticPadTemplate* getStaticPadTemplateAt(void* factory, guint i)
+// {
+//   return g_list_nth_data((GList*)gst_element_factory_get_static_pad_templates(factory), i);
+// }
+import "C"
+
+import (
+	"fmt"
+	"runtime"
+	"unsafe"
+)
+
+// Rank is a priority of the plugin feature used on autoplugging.
+type Rank int
+
+const (
+	// RankNone states that the feature is never autoplugged.
+	RankNone Rank = 0
+	// RankMarginal states that the feature is unlikely to be autoplugged.
+	RankMarginal Rank = 64
+	// RankSecondary states that the feature is autoplugged if no primary one is available.
+	RankSecondary Rank = 128
+	// RankPrimary states that the feature is most likely to be autoplugged.
+	RankPrimary Rank = 256
+)
+
+// String returns string representation of the Rank.
+func (r Rank) String() string {
+	switch r {
+	case RankNone:
+		return "RankNone"
+	case RankMarginal:
+		return "RankMarginal"
+	case RankSecondary:
+		return "RankSecondary"
+	case RankPrimary:
+		return "RankPrimary"
+	default:
+		return fmt.Sprintf("Rank(%d)", int(r))
+	}
+}
+
+// ElementFactory is a wrapper of GstElementFactory.
+type ElementFactory struct {
+	p unsafe.Pointer
+}
+
+// NewElementFromFactory creates a new element by the factory name.
+// If name is empty, unique name is assigned.
+func NewElementFromFactory(factory, name string) (*Element, error) {
+	cFactory := C.CString(factory)
+	defer C.free(unsafe.Pointer(cFactory))
+	var cName *C.char
+	if name != "" {
+		cName = C.CString(name)
+		defer C.free(unsafe.Pointer(cName))
+	}
+	e := C.makeElement(cFactory, cName)
+	if e == nil {
+		return nil, fmt.Errorf("Failed to create element of %s", factory)
+	}
+	return NewElement(unsafe.Pointer(e)), nil
+}
+
+// FindElementFactory finds the element factory by the name.
+func FindElementFactory(name string) (*ElementFactory, error) {
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+	f := C.gst_element_factory_find(cName)
+	if f == nil {
+		return nil, fmt.Errorf("Element factory %s not found", name)
+	}
+	return newElementFactory(unsafe.Pointer(f)), nil
+}
+
+func newElementFactory(p unsafe.Pointer) *ElementFactory {
+	f := &ElementFactory{p: p}
+	runtime.SetFinalizer(f, finalizeElementFactory)
+	return f
+}
+
+func newElementFactoryRef(p unsafe.Pointer) *ElementFactory {
+	C.gst_object_ref(C.gpointer(p))
+	return newElementFactory(p)
+}
+
+func finalizeElementFactory(f *ElementFactory) {
+	C.gst_object_unref(C.gpointer(f.p))
+}
+
+// Factory returns the factory of the element.
+func (s *Element) Factory() (*ElementFactory, error) {
+	f := C.getElementFactory(s.UnsafePointer())
+	if f == nil {
+		return nil, fmt.Errorf("Element has no factory")
+	}
+	return newElementFactory(unsafe.Pointer(f)), nil
+}
+
+// UnsafePointer returns the raw pointer of the factory.
+func (f *ElementFactory) UnsafePointer() unsafe.Pointer {
+	return f.p
+}
+
+// Create creates a new element.
+// If name is empty, unique name is assigned.
+func (f *ElementFactory) Create(name string) (*Element, error) {
+	var cName *C.char
+	if name != "" {
+		cName = C.CString(name)
+		defer C.free(unsafe.Pointer(cName))
+	}
+	e := C.createElement(f.p, cName)
+	if e == nil {
+		return nil, fmt.Errorf("Failed to create element of %s", f.Name())
+	}
+	return NewElement(unsafe.Pointer(e)), nil
+}
+
+// Name returns the name of the factory.
+func (f *ElementFactory) Name() string {
+	return featureName(f.p)
+}
+
+// LongName returns the human readable name of the element.
+func (f *ElementFactory) LongName() string {
+	return f.metadata("long-name")
+}
+
+// Klass returns the class of the element like "Codec/Encoder/Video".
+func (f *ElementFactory) Klass() string {
+	return f.metadata("klass")
+}
+
+// Description returns the description of the element.
+func (f *ElementFactory) Description() string {
+	return f.metadata("description")
+}
+
+// Author returns the author of the element.
+func (f *ElementFactory) Author() string {
+	return f.metadata("author")
+}
+
+// Rank returns the rank of the element.
+func (f *ElementFactory) Rank() Rank {
+	return Rank(C.gst_plugin_feature_get_rank((*C.GstPluginFeature)(f.p)))
+}
+
+// PadTemplates returns the pad templates of the element.
+func (f *ElementFactory) PadTemplates() []PadTemplate {
+	var ret []PadTemplate
+	for i := C.guint(0); ; i++ {
+		t := C.getStaticPadTemplateAt(f.p, i)
+		if t == nil {
+			break
+		}
+		ret = append(ret, PadTemplate{
+			Name:      C.GoString(t.name_template),
+			Direction: PadDirection(t.direction),
+			Presence:  PadPresence(t.presence),
+			Caps:      C.GoString(t.static_caps.string),
+		})
+	}
+	return ret
+}
+
+func (f *ElementFactory) metadata(key string) string {
+	cKey := C.CString(key)
+	defer C.free(unsafe.Pointer(cKey))
+	return C.GoString(C.getFactoryMetadata(f.p, cKey))
+}
+
+func featureName(p unsafe.Pointer) string {
+	return C.GoString(C.getFeatureName(p))
+}
@@ -0,0 +1,91 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gst
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewElementFromFactory(t *testing.T) {
+	e, err := NewElementFromFactory("fakesink", "the-sink")
+	if err != nil {
+		t.Fatalf("Failed to create element: %v", err)
+	}
+	name, err := e.GetProperty("name")
+	if err != nil {
+		t.Fatalf("Failed to GetProperty: %v", err)
+	}
+	if name != "the-sink" {
+		t.Errorf("Element name must be \"the-sink\", but got %v", name)
+	}
+	f, err := e.Factory()
+	if err != nil {
+		t.Fatalf("Failed to get factory: %v", err)
+	}
+	if n := f.Name(); n != "fakesink" {
+		t.Errorf("Factory name must be \"fakesink\", but got %s", n)
+	}
+
+	if _, err := NewElementFromFactory("inexistent-factory", ""); err == nil {
+		t.Error("NewElementFromFactory with inexistent factory must fail")
+	}
+}
+
+func TestFindElementFactory(t *testing.T) {
+	f, err := FindElementFactory("queue")
+	if err != nil {
+		t.Fatalf("Failed to find factory: %v", err)
+	}
+	if n := f.LongName(); n != "Queue" {
+		t.Errorf("Unexpected long name %s", n)
+	}
+	if k := f.Klass(); k != "Generic" {
+		t.Errorf("Unexpected klass %s", k)
+	}
+	if f.Description() == "" || f.Author() == "" {
+		t.Error("Description and author must not be empty")
+	}
+	if r := f.Rank(); r != RankNone {
+		t.Errorf("Unexpected rank %s", r)
+	}
+
+	var dirs []PadDirection
+	for _, tmpl := range f.PadTemplates() {
+		if tmpl.Presence != PadAlways {
+			t.Errorf("Pad %s must be PadAlways, but got %s", tmpl.Name, tmpl.Presence)
+		}
+		if tmpl.Caps != "ANY" {
+			t.Errorf("Caps of pad %s must be ANY, but got %s", tmpl.Name, tmpl.Caps)
+		}
+		dirs = append(dirs, tmpl.Direction)
+	}
+	if !reflect.DeepEqual([]PadDirection{PadSink, PadSrc}, dirs) &&
+		!reflect.DeepEqual([]PadDirection{PadSrc, PadSink}, dirs) {
+		t.Errorf("Unexpected pad templates %v", dirs)
+	}
+
+	e, err := f.Create("")
+	if err != nil {
+		t.Fatalf("Failed to create element: %v", err)
+	}
+	if s := e.State(); s != StateNull {
+		t.Errorf("Element state must be StateNull, but got %s", s)
+	}
+
+	if _, err := FindElementFactory("inexistent-factory"); err == nil {
+		t.Error("FindElementFactory with inexistent factory must fail")
+	}
+}
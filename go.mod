@@ -1,3 +1,3 @@
 module github.com/seqsense/sq-gst-go
 
-go 1.13
+go 1.17
@@ -0,0 +1,166 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gstlaunch
+
+// #cgo pkg-config: gstreamer-1.0
+// #include <gst/gst.h>
+import "C"
+
+import (
+	"log"
+	"time"
+	"unsafe"
+
+	gst "github.com/seqsense/sq-gst-go"
+)
+
+// Buffering is a buffering status reported by the element.
+type Buffering struct {
+	// Percent is the buffering level in 0-100.
+	Percent int
+	// Mode is the buffering method of the element.
+	Mode gst.BufferingMode
+	// AvgIn is the average input rate in bytes per second.
+	AvgIn int
+	// AvgOut is the average output rate in bytes per second.
+	AvgOut int
+	// Left is the estimated time remaining to complete the buffering.
+	// It is negative if unknown.
+	Left time.Duration
+}
+
+// RegisterBufferingCallback registers buffering message handler callback.
+// The callback receives the element posted the message and the buffering status.
+func (l *GstLaunch) RegisterBufferingCallback(f func(*GstLaunch, *gst.Element, *Buffering)) error {
+	if l.closed.Load().(bool) {
+		return errClosed
+	}
+	l.mu.Lock()
+	l.cbBuffering = f
+	l.mu.Unlock()
+	return nil
+}
+
+// EnableBufferingControl enables or disables the built-in buffering policy.
+// If enabled, the pipeline started by Start is paused while the buffering level is
+// below 100% and resumed when the buffering is completed.
+// The policy is not applied to live pipelines since they can not be paused
+// without losing the data.
+// Disabling the policy resumes the pipeline paused by the policy.
+func (l *GstLaunch) EnableBufferingControl(enable bool) error {
+	if l.closed.Load().(bool) {
+		return errClosed
+	}
+	l.mu.Lock()
+	l.bufferingControl = enable
+	resume := !enable && l.buffering && l.targetState == gst.StatePlaying
+	if !enable {
+		l.buffering = false
+		l.bufferingLevels = nil
+	}
+	l.mu.Unlock()
+
+	if resume {
+		if _, err := l.pipeline().SetState(gst.StatePlaying); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Buffering returns true if the pipeline is paused by the built-in buffering policy.
+func (l *GstLaunch) Buffering() bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.buffering
+}
+
+func (l *GstLaunch) setTargetState(st gst.State, ret gst.StateChangeReturn) {
+	l.mu.Lock()
+	l.targetState = st
+	switch ret {
+	case gst.StateChangeNoPreroll:
+		l.live = true
+	case gst.StateChangeFailure:
+	default:
+		l.live = false
+	}
+	l.mu.Unlock()
+}
+
+// resetTargetState clears the target state and the buffering status
+// to stop the built-in buffering policy.
+func (l *GstLaunch) resetTargetState() {
+	l.mu.Lock()
+	l.targetState = gst.StateNull
+	l.buffering = false
+	l.bufferingLevels = nil
+	l.mu.Unlock()
+}
+
+// bufferingLevel updates the buffering level of the source and returns
+// the lowest level of all buffering elements.
+// It must be called with l.mu locked.
+func (l *GstLaunch) bufferingLevel(src unsafe.Pointer, percent int) int {
+	if percent >= 100 {
+		delete(l.bufferingLevels, src)
+	} else {
+		if l.bufferingLevels == nil {
+			l.bufferingLevels = make(map[unsafe.Pointer]int)
+		}
+		l.bufferingLevels[src] = percent
+	}
+	lowest := 100
+	for _, p := range l.bufferingLevels {
+		if p < lowest {
+			lowest = p
+		}
+	}
+	return lowest
+}
+
+func (l *GstLaunch) handleBuffering(msg *C.GstMessage) {
+	b := parseBuffering(msg)
+
+	// Follows the buffering algorithm described in the GStreamer application
+	// development manual.
+	// The pipeline is resumed when all buffering elements reached 100%.
+	state := gst.StateVoidPending
+	l.mu.Lock()
+	cb := l.cbBuffering
+	if l.bufferingControl && !l.live {
+		if l.bufferingLevel(unsafe.Pointer(msg.src), b.Percent) >= 100 {
+			if l.buffering && l.targetState == gst.StatePlaying {
+				state = gst.StatePlaying
+			}
+			l.buffering = false
+		} else {
+			if !l.buffering && l.targetState == gst.StatePlaying {
+				state = gst.StatePaused
+			}
+			l.buffering = true
+		}
+	}
+	l.mu.Unlock()
+
+	if state != gst.StateVoidPending && !l.closed.Load().(bool) {
+		if _, err := l.pipeline().SetState(state); err != nil {
+			log.Printf("Failed to change state to %s on buffering: %v", state, err)
+		}
+	}
+	if cb != nil {
+		cb(l, messageSource(msg), b)
+	}
+}
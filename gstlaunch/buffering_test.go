@@ -0,0 +1,215 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gstlaunch
+
+import (
+	"testing"
+	"time"
+
+	gst "github.com/seqsense/sq-gst-go"
+	"github.com/seqsense/sq-gst-go/internal/dummyelement"
+)
+
+func TestBufferingCallback(t *testing.T) {
+	l := MustNew("audiotestsrc ! fakesink name=sink")
+	defer l.Kill()
+
+	ch := make(chan *Buffering, 1)
+	l.RegisterBufferingCallback(func(l *GstLaunch, e *gst.Element, b *Buffering) {
+		if name, _ := e.GetProperty("name"); name != "sink" {
+			t.Errorf("unexpected source %v, expected \"sink\"", name)
+		}
+		ch <- b
+	})
+	l.Start()
+
+	sink, err := l.GetElement("sink")
+	if err != nil {
+		t.Fatalf("failed to get fakesink element: %v", err)
+	}
+	dummyelement.PostBuffering(sink.UnsafePointer(), 30, 1500)
+
+	select {
+	case b := <-ch:
+		expected := &Buffering{
+			Percent: 30,
+			Mode:    gst.BufferingStream,
+			Left:    1500 * time.Millisecond,
+		}
+		if *b != *expected {
+			t.Errorf("expected %+v, got %+v", *expected, *b)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected buffering message, but timed-out")
+	}
+}
+
+func TestBufferingControl(t *testing.T) {
+	testCases := map[string]struct {
+		launch      string
+		pausedState gst.State
+	}{
+		"NonLive": {"audiotestsrc ! fakesink name=sink", gst.StatePaused},
+		"Live":    {"audiotestsrc is-live=true ! fakesink name=sink", gst.StatePlaying},
+	}
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			l := MustNew(tt.launch)
+			defer l.Kill()
+
+			if err := l.EnableBufferingControl(true); err != nil {
+				t.Fatalf("failed to enable buffering control: %v", err)
+			}
+			states := make(chan gst.State, 10)
+			l.RegisterStateCallback(func(l *GstLaunch, o, n, p gst.State) {
+				select {
+				case states <- n:
+				default:
+				}
+			})
+			l.Start()
+			waitState(t, states, gst.StatePlaying)
+
+			sink, err := l.GetElement("sink")
+			if err != nil {
+				t.Fatalf("failed to get fakesink element: %v", err)
+			}
+			p, err := l.Pipeline()
+			if err != nil {
+				t.Fatalf("failed to get pipeline: %v", err)
+			}
+
+			dummyelement.PostBuffering(sink.UnsafePointer(), 50, -1)
+			if tt.pausedState == gst.StatePaused {
+				waitState(t, states, gst.StatePaused)
+				if !l.Buffering() {
+					t.Error("pipeline must be buffering")
+				}
+			} else {
+				<-time.After(100 * time.Millisecond)
+				if l.Buffering() {
+					t.Error("live pipeline must not be buffering")
+				}
+			}
+			if st := p.State(); st != tt.pausedState {
+				t.Errorf("expected %s during buffering, got %s", tt.pausedState, st)
+			}
+
+			dummyelement.PostBuffering(sink.UnsafePointer(), 100, 0)
+			if tt.pausedState == gst.StatePaused {
+				waitState(t, states, gst.StatePlaying)
+			} else {
+				<-time.After(100 * time.Millisecond)
+			}
+			if l.Buffering() {
+				t.Error("pipeline must not be buffering after 100%")
+			}
+			if st := p.State(); st != gst.StatePlaying {
+				t.Errorf("expected StatePlaying after buffering, got %s", st)
+			}
+		})
+	}
+}
+
+func TestBufferingControl_disable(t *testing.T) {
+	l := MustNew("audiotestsrc ! fakesink name=sink")
+	defer l.Kill()
+
+	if err := l.EnableBufferingControl(true); err != nil {
+		t.Fatalf("failed to enable buffering control: %v", err)
+	}
+	states := make(chan gst.State, 10)
+	l.RegisterStateCallback(func(l *GstLaunch, o, n, p gst.State) {
+		select {
+		case states <- n:
+		default:
+		}
+	})
+	l.Start()
+	waitState(t, states, gst.StatePlaying)
+
+	sink, err := l.GetElement("sink")
+	if err != nil {
+		t.Fatalf("failed to get fakesink element: %v", err)
+	}
+	dummyelement.PostBuffering(sink.UnsafePointer(), 50, -1)
+	waitState(t, states, gst.StatePaused)
+
+	if err := l.EnableBufferingControl(false); err != nil {
+		t.Fatalf("failed to disable buffering control: %v", err)
+	}
+	waitState(t, states, gst.StatePlaying)
+	if l.Buffering() {
+		t.Error("pipeline must not be buffering after disabling the policy")
+	}
+}
+
+func TestBufferingControl_multipleSources(t *testing.T) {
+	l := MustNew("audiotestsrc ! queue name=q ! fakesink name=sink")
+	defer l.Kill()
+
+	if err := l.EnableBufferingControl(true); err != nil {
+		t.Fatalf("failed to enable buffering control: %v", err)
+	}
+	states := make(chan gst.State, 10)
+	l.RegisterStateCallback(func(l *GstLaunch, o, n, p gst.State) {
+		select {
+		case states <- n:
+		default:
+		}
+	})
+	l.Start()
+	waitState(t, states, gst.StatePlaying)
+
+	q, err := l.GetElement("q")
+	if err != nil {
+		t.Fatalf("failed to get queue element: %v", err)
+	}
+	sink, err := l.GetElement("sink")
+	if err != nil {
+		t.Fatalf("failed to get fakesink element: %v", err)
+	}
+	dummyelement.PostBuffering(q.UnsafePointer(), 30, -1)
+	dummyelement.PostBuffering(sink.UnsafePointer(), 40, -1)
+	waitState(t, states, gst.StatePaused)
+
+	dummyelement.PostBuffering(sink.UnsafePointer(), 100, 0)
+	<-time.After(100 * time.Millisecond)
+	if !l.Buffering() {
+		t.Error("pipeline must be buffering until all elements reach 100%")
+	}
+
+	dummyelement.PostBuffering(q.UnsafePointer(), 100, 0)
+	waitState(t, states, gst.StatePlaying)
+	if l.Buffering() {
+		t.Error("pipeline must not be buffering after all elements reached 100%")
+	}
+}
+
+func waitState(t *testing.T, ch <-chan gst.State, expected gst.State) {
+	t.Helper()
+	timeout := time.After(time.Second)
+	for {
+		select {
+		case st := <-ch:
+			if st == expected {
+				return
+			}
+		case <-timeout:
+			t.Fatalf("expected state %s, but timed-out", expected)
+		}
+	}
+}
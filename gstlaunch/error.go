@@ -0,0 +1,170 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gstlaunch
+
+// #cgo pkg-config: gstreamer-1.0
+// #include <gst/gst.h>
+import "C"
+
+import (
+	"fmt"
+
+	gst "github.com/seqsense/sq-gst-go"
+)
+
+// ErrorDomain is a GError domain of the GStreamer errors.
+type ErrorDomain int
+
+// ErrorDomain values.
+const (
+	// ErrorDomainUnknown is a domain other than the GStreamer error domains.
+	ErrorDomainUnknown ErrorDomain = iota
+	// ErrorDomainCore is the domain of the GStreamer core errors (GST_CORE_ERROR).
+	ErrorDomainCore
+	// ErrorDomainLibrary is the domain of the errors in the supporting libraries (GST_LIBRARY_ERROR).
+	ErrorDomainLibrary
+	// ErrorDomainResource is the domain of the errors accessing the resources (GST_RESOURCE_ERROR).
+	ErrorDomainResource
+	// ErrorDomainStream is the domain of the errors processing the stream (GST_STREAM_ERROR).
+	ErrorDomainStream
+)
+
+var errorDomainQuarks = map[string]ErrorDomain{
+	"gst-core-error-quark":     ErrorDomainCore,
+	"gst-library-error-quark":  ErrorDomainLibrary,
+	"gst-resource-error-quark": ErrorDomainResource,
+	"gst-stream-error-quark":   ErrorDomainStream,
+}
+
+func errorDomain(quark string) ErrorDomain {
+	return errorDomainQuarks[quark]
+}
+
+// String returns the name of the ErrorDomain like "RESOURCE".
+func (d ErrorDomain) String() string {
+	switch d {
+	case ErrorDomainCore:
+		return "CORE"
+	case ErrorDomainLibrary:
+		return "LIBRARY"
+	case ErrorDomainResource:
+		return "RESOURCE"
+	case ErrorDomainStream:
+		return "STREAM"
+	}
+	return "UNKNOWN"
+}
+
+// Error is an error message posted on the pipeline.
+type Error struct {
+	// Domain is the GError domain.
+	// ErrorDomainUnknown is set if the error is not in the GStreamer error domains.
+	Domain ErrorDomain
+	// DomainQuark is the raw GError domain string like "gst-resource-error-quark".
+	// It distinguishes the errors of ErrorDomainUnknown like "g-io-error-quark".
+	DomainQuark string
+	// Code is the GError code in the domain like GST_RESOURCE_ERROR_NOT_FOUND.
+	Code int
+	// Source is the element which posted the message.
+	// It is nil if the message is not posted by an element.
+	Source *gst.Element
+	// SourcePath is the full path of the source object like
+	// "/GstPipeline:pipeline0/GstFileSrc:filesrc0".
+	SourcePath string
+	// Message is the message of the GError.
+	Message string
+	// DebugInfo is the additional debug information.
+	DebugInfo string
+}
+
+func (e *Error) Error() string {
+	if e.SourcePath == "" {
+		return e.Message
+	}
+	return fmt.Sprintf("%s: %s", e.SourcePath, e.Message)
+}
+
+// Is returns true if the target is an *Error of the same domain and code.
+// Errors of ErrorDomainUnknown are compared by DomainQuark.
+// It makes errors.Is work with the sentinel errors like ErrResourceNotFound.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	if !ok {
+		return false
+	}
+	if t.Domain != e.Domain || t.Code != e.Code {
+		return false
+	}
+	return e.Domain != ErrorDomainUnknown || t.DomainQuark == e.DomainQuark
+}
+
+func sentinelError(domain ErrorDomain, code C.int, msg string) *Error {
+	return &Error{Domain: domain, Code: int(code), Message: msg}
+}
+
+// Sentinel errors to be compared by errors.Is.
+var (
+	ErrCoreFailed         = sentinelError(ErrorDomainCore, C.GST_CORE_ERROR_FAILED, "core error")
+	ErrCoreTooLazy        = sentinelError(ErrorDomainCore, C.GST_CORE_ERROR_TOO_LAZY, "not implemented yet")
+	ErrCoreNotImplemented = sentinelError(ErrorDomainCore, C.GST_CORE_ERROR_NOT_IMPLEMENTED, "not implemented")
+	ErrCoreStateChange    = sentinelError(ErrorDomainCore, C.GST_CORE_ERROR_STATE_CHANGE, "state change failed")
+	ErrCorePad            = sentinelError(ErrorDomainCore, C.GST_CORE_ERROR_PAD, "pad error")
+	ErrCoreThread         = sentinelError(ErrorDomainCore, C.GST_CORE_ERROR_THREAD, "thread error")
+	ErrCoreNegotiation    = sentinelError(ErrorDomainCore, C.GST_CORE_ERROR_NEGOTIATION, "negotiation failed")
+	ErrCoreEvent          = sentinelError(ErrorDomainCore, C.GST_CORE_ERROR_EVENT, "event error")
+	ErrCoreSeek           = sentinelError(ErrorDomainCore, C.GST_CORE_ERROR_SEEK, "seek failed")
+	ErrCoreCaps           = sentinelError(ErrorDomainCore, C.GST_CORE_ERROR_CAPS, "caps error")
+	ErrCoreTag            = sentinelError(ErrorDomainCore, C.GST_CORE_ERROR_TAG, "tag error")
+	ErrCoreMissingPlugin  = sentinelError(ErrorDomainCore, C.GST_CORE_ERROR_MISSING_PLUGIN, "missing plugin")
+	ErrCoreClock          = sentinelError(ErrorDomainCore, C.GST_CORE_ERROR_CLOCK, "clock error")
+	ErrCoreDisabled       = sentinelError(ErrorDomainCore, C.GST_CORE_ERROR_DISABLED, "feature disabled")
+
+	ErrLibraryFailed   = sentinelError(ErrorDomainLibrary, C.GST_LIBRARY_ERROR_FAILED, "library error")
+	ErrLibraryTooLazy  = sentinelError(ErrorDomainLibrary, C.GST_LIBRARY_ERROR_TOO_LAZY, "library feature not implemented yet")
+	ErrLibraryInit     = sentinelError(ErrorDomainLibrary, C.GST_LIBRARY_ERROR_INIT, "library initialization failed")
+	ErrLibraryShutdown = sentinelError(ErrorDomainLibrary, C.GST_LIBRARY_ERROR_SHUTDOWN, "library shutdown failed")
+	ErrLibrarySettings = sentinelError(ErrorDomainLibrary, C.GST_LIBRARY_ERROR_SETTINGS, "library settings error")
+	ErrLibraryEncode   = sentinelError(ErrorDomainLibrary, C.GST_LIBRARY_ERROR_ENCODE, "library encode error")
+
+	ErrResourceFailed        = sentinelError(ErrorDomainResource, C.GST_RESOURCE_ERROR_FAILED, "resource error")
+	ErrResourceTooLazy       = sentinelError(ErrorDomainResource, C.GST_RESOURCE_ERROR_TOO_LAZY, "resource feature not implemented yet")
+	ErrResourceNotFound      = sentinelError(ErrorDomainResource, C.GST_RESOURCE_ERROR_NOT_FOUND, "resource not found")
+	ErrResourceBusy          = sentinelError(ErrorDomainResource, C.GST_RESOURCE_ERROR_BUSY, "resource busy")
+	ErrResourceOpenRead      = sentinelError(ErrorDomainResource, C.GST_RESOURCE_ERROR_OPEN_READ, "failed to open resource for reading")
+	ErrResourceOpenWrite     = sentinelError(ErrorDomainResource, C.GST_RESOURCE_ERROR_OPEN_WRITE, "failed to open resource for writing")
+	ErrResourceOpenReadWrite = sentinelError(ErrorDomainResource, C.GST_RESOURCE_ERROR_OPEN_READ_WRITE, "failed to open resource for reading and writing")
+	ErrResourceClose         = sentinelError(ErrorDomainResource, C.GST_RESOURCE_ERROR_CLOSE, "failed to close resource")
+	ErrResourceRead          = sentinelError(ErrorDomainResource, C.GST_RESOURCE_ERROR_READ, "failed to read resource")
+	ErrResourceWrite         = sentinelError(ErrorDomainResource, C.GST_RESOURCE_ERROR_WRITE, "failed to write resource")
+	ErrResourceSeek          = sentinelError(ErrorDomainResource, C.GST_RESOURCE_ERROR_SEEK, "failed to seek resource")
+	ErrResourceSync          = sentinelError(ErrorDomainResource, C.GST_RESOURCE_ERROR_SYNC, "failed to sync resource")
+	ErrResourceSettings      = sentinelError(ErrorDomainResource, C.GST_RESOURCE_ERROR_SETTINGS, "resource settings error")
+	ErrResourceNoSpaceLeft   = sentinelError(ErrorDomainResource, C.GST_RESOURCE_ERROR_NO_SPACE_LEFT, "no space left on resource")
+	ErrResourceNotAuthorized = sentinelError(ErrorDomainResource, C.GST_RESOURCE_ERROR_NOT_AUTHORIZED, "not authorized to access resource")
+
+	ErrStreamFailed         = sentinelError(ErrorDomainStream, C.GST_STREAM_ERROR_FAILED, "stream error")
+	ErrStreamTooLazy        = sentinelError(ErrorDomainStream, C.GST_STREAM_ERROR_TOO_LAZY, "stream feature not implemented yet")
+	ErrStreamNotImplemented = sentinelError(ErrorDomainStream, C.GST_STREAM_ERROR_NOT_IMPLEMENTED, "stream not implemented")
+	ErrStreamTypeNotFound   = sentinelError(ErrorDomainStream, C.GST_STREAM_ERROR_TYPE_NOT_FOUND, "stream type not found")
+	ErrStreamWrongType      = sentinelError(ErrorDomainStream, C.GST_STREAM_ERROR_WRONG_TYPE, "wrong stream type")
+	ErrStreamCodecNotFound  = sentinelError(ErrorDomainStream, C.GST_STREAM_ERROR_CODEC_NOT_FOUND, "codec not found")
+	ErrStreamDecode         = sentinelError(ErrorDomainStream, C.GST_STREAM_ERROR_DECODE, "failed to decode stream")
+	ErrStreamEncode         = sentinelError(ErrorDomainStream, C.GST_STREAM_ERROR_ENCODE, "failed to encode stream")
+	ErrStreamDemux          = sentinelError(ErrorDomainStream, C.GST_STREAM_ERROR_DEMUX, "failed to demux stream")
+	ErrStreamMux            = sentinelError(ErrorDomainStream, C.GST_STREAM_ERROR_MUX, "failed to mux stream")
+	ErrStreamFormat         = sentinelError(ErrorDomainStream, C.GST_STREAM_ERROR_FORMAT, "wrong stream format")
+	ErrStreamDecrypt        = sentinelError(ErrorDomainStream, C.GST_STREAM_ERROR_DECRYPT, "failed to decrypt stream")
+	ErrStreamDecryptNoKey   = sentinelError(ErrorDomainStream, C.GST_STREAM_ERROR_DECRYPT_NOKEY, "no key to decrypt stream")
+)
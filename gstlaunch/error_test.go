@@ -0,0 +1,142 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gstlaunch
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestError_Is(t *testing.T) {
+	err := &Error{
+		Domain:     ErrorDomainResource,
+		Code:       ErrResourceNotFound.Code,
+		SourcePath: "/GstPipeline:pipeline0/GstFileSrc:filesrc0",
+		Message:    "Resource not found.",
+	}
+	if !errors.Is(err, ErrResourceNotFound) {
+		t.Error("error must match ErrResourceNotFound")
+	}
+	if !errors.Is(fmt.Errorf("wrapped: %w", err), ErrResourceNotFound) {
+		t.Error("wrapped error must match ErrResourceNotFound")
+	}
+	if errors.Is(err, ErrStreamDecode) {
+		t.Error("error must not match ErrStreamDecode")
+	}
+	if errors.Is(err, ErrResourceFailed) {
+		t.Error("error must not match ErrResourceFailed")
+	}
+	if s := err.Error(); s != "/GstPipeline:pipeline0/GstFileSrc:filesrc0: Resource not found." {
+		t.Errorf("unexpected error string %s", s)
+	}
+}
+
+func TestError_IsUnknownDomain(t *testing.T) {
+	ioErr := &Error{DomainQuark: "g-io-error-quark", Code: 1}
+	testCases := map[string]struct {
+		target error
+		is     bool
+	}{
+		"SameQuark":      {&Error{DomainQuark: "g-io-error-quark", Code: 1}, true},
+		"DifferentQuark": {&Error{DomainQuark: "g-resolver-error-quark", Code: 1}, false},
+		"DifferentCode":  {&Error{DomainQuark: "g-io-error-quark", Code: 2}, false},
+		"KnownDomain":    {ErrCoreFailed, false},
+	}
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			if is := errors.Is(ioErr, tt.target); is != tt.is {
+				t.Errorf("expected %v, got %v", tt.is, is)
+			}
+		})
+	}
+}
+
+func TestError_sentinels(t *testing.T) {
+	sentinels := []*Error{
+		ErrCoreFailed, ErrCoreTooLazy, ErrCoreNotImplemented, ErrCoreStateChange,
+		ErrCorePad, ErrCoreThread, ErrCoreNegotiation, ErrCoreEvent, ErrCoreSeek,
+		ErrCoreCaps, ErrCoreTag, ErrCoreMissingPlugin, ErrCoreClock, ErrCoreDisabled,
+
+		ErrLibraryFailed, ErrLibraryTooLazy, ErrLibraryInit, ErrLibraryShutdown,
+		ErrLibrarySettings, ErrLibraryEncode,
+
+		ErrResourceFailed, ErrResourceTooLazy, ErrResourceNotFound, ErrResourceBusy,
+		ErrResourceOpenRead, ErrResourceOpenWrite, ErrResourceOpenReadWrite,
+		ErrResourceClose, ErrResourceRead, ErrResourceWrite, ErrResourceSeek,
+		ErrResourceSync, ErrResourceSettings, ErrResourceNoSpaceLeft,
+		ErrResourceNotAuthorized,
+
+		ErrStreamFailed, ErrStreamTooLazy, ErrStreamNotImplemented,
+		ErrStreamTypeNotFound, ErrStreamWrongType, ErrStreamCodecNotFound,
+		ErrStreamDecode, ErrStreamEncode, ErrStreamDemux, ErrStreamMux,
+		ErrStreamFormat, ErrStreamDecrypt, ErrStreamDecryptNoKey,
+	}
+	// Codes of each domain must cover the enum without gaps.
+	next := map[ErrorDomain]int{}
+	for _, err := range sentinels {
+		next[err.Domain]++
+		if err.Code != next[err.Domain] {
+			t.Errorf("expected %s error code %d, got %d (%s)", err.Domain, next[err.Domain], err.Code, err.Message)
+		}
+	}
+}
+
+func TestErrorDomain(t *testing.T) {
+	testCases := map[string]ErrorDomain{
+		"gst-core-error-quark":     ErrorDomainCore,
+		"gst-library-error-quark":  ErrorDomainLibrary,
+		"gst-resource-error-quark": ErrorDomainResource,
+		"gst-stream-error-quark":   ErrorDomainStream,
+		"g-io-error-quark":         ErrorDomainUnknown,
+	}
+	for quark, expected := range testCases {
+		if d := errorDomain(quark); d != expected {
+			t.Errorf("expected %s for %s, got %s", expected, quark, d)
+		}
+	}
+}
+
+func TestLaunch_structuredErrorHandling(t *testing.T) {
+	l := MustNew("filesrc name=src location=/nonexistent/file ! fakesink")
+	defer l.Kill()
+
+	errCh := make(chan *Error, 1)
+	l.RegisterStructuredErrorCallback(func(l *GstLaunch, err *Error) {
+		errCh <- err
+	})
+	l.Start()
+
+	select {
+	case err := <-errCh:
+		if !errors.Is(err, ErrResourceNotFound) {
+			t.Errorf("expected ErrResourceNotFound, got %s error %d: %v", err.Domain, err.Code, err)
+		}
+		if err.Source == nil {
+			t.Fatal("source element must be set")
+		}
+		if name, _ := err.Source.GetProperty("name"); name != "src" {
+			t.Errorf("unexpected source %v, expected \"src\"", name)
+		}
+		if !strings.HasSuffix(err.SourcePath, ":src") {
+			t.Errorf("unexpected source path %s", err.SourcePath)
+		}
+	case <-time.After(time.Second):
+		t.Error("expected error message, but timed-out")
+	}
+}
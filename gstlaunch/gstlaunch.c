@@ -26,21 +26,6 @@
 
 static GMutex g_mutex;
 
-gpointer runMainloop(gpointer mainloop)
-{
-  g_main_loop_run(mainloop);
-  return NULL;
-}
-void init(char* exec_name)
-{
-  int argc = 1;
-  char** argv = &exec_name;
-  gst_init(&argc, &argv);
-
-  GMainLoop* mainloop = g_main_loop_new(NULL, FALSE);
-  GThread* thread = g_thread_new("mainloop", runMainloop, mainloop);
-}
-
 static gboolean cbMessage(GstBus* bus, GstMessage* msg, gpointer p)
 {
   Context* ctx = (Context*)p;
@@ -56,59 +41,116 @@ static gboolean cbMessage(GstBus* bus, GstMessage* msg, gpointer p)
   }
   g_mutex_unlock(&ctx->mutex);
 
-  if ((GST_MESSAGE_TYPE(msg) & GST_MESSAGE_EOS))
-    goCbEOS(ctx->user_int);
-
-  if ((GST_MESSAGE_TYPE(msg) & GST_MESSAGE_ERROR))
+  switch (GST_MESSAGE_TYPE(msg))
   {
-    GError* err = NULL;
-    gchar* dbg_info = NULL;
+    case GST_MESSAGE_EOS:
+      goCbEOS(ctx->user_int);
+      break;
+    case GST_MESSAGE_ERROR:
+    {
+      GError* err = NULL;
+      gchar* dbg_info = NULL;
 
-    gst_message_parse_error(msg, &err, &dbg_info);
-    int dbg_info_size = 0;
-    if (dbg_info != NULL)
-      dbg_info_size = strlen(dbg_info);
+      gst_message_parse_error(msg, &err, &dbg_info);
+      if (err == NULL)
+      {
+        g_free(dbg_info);
+        break;
+      }
+      int dbg_info_size = 0;
+      if (dbg_info != NULL)
+        dbg_info_size = strlen(dbg_info);
 
-    goCbError(
-        ctx->user_int, (void*)GST_MESSAGE_SRC(msg),
-        err->message, strlen(err->message), dbg_info, dbg_info_size);
+      gchar* path = gst_object_get_path_string(GST_MESSAGE_SRC(msg));
 
-    g_error_free(err);
-    g_free(dbg_info);
-  }
+      goCbError(
+          ctx->user_int, (void*)GST_MESSAGE_SRC(msg), GST_IS_ELEMENT(GST_MESSAGE_SRC(msg)),
+          (char*)g_quark_to_string(err->domain), err->code, path,
+          err->message, strlen(err->message), dbg_info, dbg_info_size);
 
-  if ((GST_MESSAGE_TYPE(msg) & GST_MESSAGE_STATE_CHANGED))
-  {
-    if ((void*)GST_MESSAGE_SRC(msg) == (void*)ctx->pipeline)
+      g_error_free(err);
+      g_free(dbg_info);
+      g_free(path);
+      break;
+    }
+    case GST_MESSAGE_WARNING:
+    case GST_MESSAGE_INFO:
+    {
+      GError* err = NULL;
+      gchar* dbg_info = NULL;
+      void* src = NULL;
+
+      if (GST_MESSAGE_TYPE(msg) == GST_MESSAGE_WARNING)
+        gst_message_parse_warning(msg, &err, &dbg_info);
+      else
+        gst_message_parse_info(msg, &err, &dbg_info);
+      if (err == NULL)
+      {
+        g_free(dbg_info);
+        break;
+      }
+      if (GST_IS_ELEMENT(GST_MESSAGE_SRC(msg)))
+        src = (void*)GST_MESSAGE_SRC(msg);
+
+      goCbReport(
+          ctx->user_int, GST_MESSAGE_TYPE(msg), src,
+          (char*)g_quark_to_string(err->domain), err->code, err->message, dbg_info);
+
+      g_error_free(err);
+      g_free(dbg_info);
+      break;
+    }
+    case GST_MESSAGE_STATE_CHANGED:
     {
       GstState old_state, new_state, pending_state;
       gst_message_parse_state_changed(msg, &old_state, &new_state, &pending_state);
-      goCbState(ctx->user_int, old_state, new_state, pending_state);
+      if (GST_IS_ELEMENT(GST_MESSAGE_SRC(msg)))
+      {
+        goCbElementState(
+            ctx->user_int, (void*)GST_MESSAGE_SRC(msg), old_state, new_state, pending_state);
+      }
+      if ((void*)GST_MESSAGE_SRC(msg) == (void*)ctx->pipeline)
+        goCbState(ctx->user_int, old_state, new_state, pending_state);
+      break;
+    }
+    case GST_MESSAGE_SEGMENT_DONE:
+    {
+      GstFormat format;
+      gint64 position;
+      gst_message_parse_segment_done(msg, &format, &position);
+      goCbSegmentDone(ctx->user_int, format, position);
+      break;
     }
+    case GST_MESSAGE_TAG:
+    {
+      GstTagList* tags = NULL;
+      void* src = NULL;
+      gst_message_parse_tag(msg, &tags);
+      if (GST_IS_ELEMENT(GST_MESSAGE_SRC(msg)))
+        src = (void*)GST_MESSAGE_SRC(msg);
+      goCbTag(ctx->user_int, src, tags);
+      break;
+    }
+    case GST_MESSAGE_ELEMENT:
+      goCbElementMessage(ctx->user_int, (void*)msg);
+      break;
+    case GST_MESSAGE_BUFFERING:
+      goCbBuffering(ctx->user_int, (void*)msg);
+      break;
+    default:
+      break;
   }
 
+  goCbMessage(ctx->user_int, (void*)msg);
+
   return TRUE;
 }
-Context* create(const char* launch, int user_int)
+static Context* setup(GstElement* pipeline, int user_int)
 {
-  Context* ctx;
-  GstElement* pipeline;
-  GError* err = NULL;
-  GstElement* src;
-
-  g_mutex_lock(&g_mutex);
-  pipeline = gst_parse_launch(launch, &err);
-  if (pipeline == NULL)
-  {
-    g_mutex_unlock(&g_mutex);
-    fprintf(stderr, "gst_parse_launch failed: %s\n", err->message);
-    return NULL;
-  }
-  ctx = malloc(sizeof(Context));
+  Context* ctx = malloc(sizeof(Context));
   if (ctx == NULL)
   {
-    gst_object_unref(ctx->pipeline);
-    g_mutex_unlock(&g_mutex);
+    gst_object_unref(pipeline);
     fprintf(stderr, "failed to allocate memory for gstlaunch context\n");
     return NULL;
   }
@@ -125,17 +167,46 @@ Context* create(const char* launch, int user_int)
   {
     fprintf(stderr, "failed to add watch to gstlaunch context\n");
     gst_object_unref(ctx->pipeline);
+    g_mutex_clear(&ctx->mutex);
     free(ctx);
+    return NULL;
+  }
+  return ctx;
+}
+Context* create(const char* launch, int user_int)
+{
+  Context* ctx;
+  GstElement* pipeline;
+  GError* err = NULL;
+
+  g_mutex_lock(&g_mutex);
+  pipeline = gst_parse_launch(launch, &err);
+  if (pipeline == NULL)
+  {
     g_mutex_unlock(&g_mutex);
+    fprintf(stderr, "gst_parse_launch failed: %s\n", err->message);
+    g_error_free(err);
     return NULL;
   }
+  if (err != NULL)
+    g_error_free(err);
+
+  ctx = setup(pipeline, user_int);
+  g_mutex_unlock(&g_mutex);
+  return ctx;
+}
+Context* createFromPipeline(void* pipeline, int user_int)
+{
+  Context* ctx;
 
+  g_mutex_lock(&g_mutex);
+  ctx = setup(gst_object_ref(pipeline), user_int);
   g_mutex_unlock(&g_mutex);
   return ctx;
 }
-void pipelineStart(Context* ctx)
+GstStateChangeReturn pipelineStart(Context* ctx)
 {
-  gst_element_set_state(ctx->pipeline, GST_STATE_PLAYING);
+  return gst_element_set_state(ctx->pipeline, GST_STATE_PLAYING);
 }
 void pipelineStop(Context* ctx)
 {
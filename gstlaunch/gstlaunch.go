@@ -17,7 +17,6 @@ package gstlaunch
 import (
 	"fmt"
 	"log"
-	"os"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -30,22 +29,48 @@ import (
 // #include "gstlaunch.h"
 import "C"
 
-func init() {
-	n := C.CString(os.Args[0])
-	defer C.free(unsafe.Pointer(n))
-	C.init(n)
-}
-
 // GstLaunch is a wrapper of GstPipeline structured from launch string.
 type GstLaunch struct {
-	cCtx    *C.Context
-	active  atomic.Value // bool
-	closed  atomic.Value // bool
-	cbEOS   func(*GstLaunch)
-	cbError func(*GstLaunch, *gst.Element, string, string)
-	cbState func(*GstLaunch, gst.State, gst.State, gst.State)
-	index   int
-	mu      sync.RWMutex
+	cCtx              *C.Context
+	active            atomic.Value // bool
+	closed            atomic.Value // bool
+	cbEOS             func(*GstLaunch)
+	cbError           func(*GstLaunch, *gst.Element, string, string)
+	cbStructuredError func(*GstLaunch, *Error)
+	cbState           func(*GstLaunch, gst.State, gst.State, gst.State)
+	cbElementState    func(*GstLaunch, *gst.Element, gst.State, gst.State, gst.State)
+	cbSegmentDone     func(*GstLaunch, gst.Format, int64)
+	cbTag             func(*GstLaunch, *gst.Element, *gst.TagList)
+	cbWarning         func(*GstLaunch, *Report)
+	cbInfo            func(*GstLaunch, *Report)
+	cbBuffering       func(*GstLaunch, *gst.Element, *Buffering)
+	cbElementMessage  func(*GstLaunch, *gst.Element, string, map[string]interface{})
+	elementMsgNames   map[string]bool
+	bufferingControl  bool
+	buffering         bool
+	bufferingLevels   map[unsafe.Pointer]int
+	live              bool
+	targetState       gst.State
+	subs              []*gst.SignalHandler
+	msgSubs           []*messageSubscription
+	msgClosed         bool
+	index             int
+	mu                sync.RWMutex
+}
+
+// Report is a warning or info message posted by an element.
+type Report struct {
+	// Source is the element which posted the message.
+	// It is nil if the message is not posted by an element.
+	Source *gst.Element
+	// Message is the message of the GError.
+	Message string
+	// Domain is the GError domain like "gst-stream-error-quark".
+	Domain string
+	// Code is the GError code in the domain.
+	Code int
+	// DebugInfo is the additional debug information.
+	DebugInfo string
 }
 
 var (
@@ -58,10 +83,27 @@ var (
 )
 
 // New creates a new GstPipeline wrapper from launch string.
+// GStreamer is initialized with the default options if gst.Init is not called yet.
 func New(launch string) (*GstLaunch, error) {
 	cLaunch := C.CString(launch)
 	defer C.free(unsafe.Pointer(cLaunch))
 
+	return newGstLaunch(func(id C.int) *C.Context {
+		return C.create(cLaunch, id)
+	})
+}
+
+// NewFromPipeline creates a new wrapper of the pipeline constructed by the gst package API.
+func NewFromPipeline(p *gst.Pipeline) (*GstLaunch, error) {
+	return newGstLaunch(func(id C.int) *C.Context {
+		return C.createFromPipeline(p.UnsafePointer(), id)
+	})
+}
+
+func newGstLaunch(create func(C.int) *C.Context) (*GstLaunch, error) {
+	if err := gst.Init(nil); err != nil {
+		return nil, err
+	}
 	l := &GstLaunch{
 		cbEOS:   nil,
 		cbError: nil,
@@ -79,8 +121,11 @@ func New(launch string) (*GstLaunch, error) {
 
 	l.index = id
 
-	cCtx := C.create(cLaunch, C.int(id))
+	cCtx := create(C.int(id))
 	if cCtx == nil {
+		cPointerMapMutex.Lock()
+		delete(cPointerMap, id)
+		cPointerMapMutex.Unlock()
 		return nil, fmt.Errorf("Failed to create gstlaunch pipeline")
 	}
 	l.cCtx = cCtx
@@ -102,6 +147,16 @@ func (l *GstLaunch) unref() error {
 		return errClosed
 	}
 	l.closed.Store(true)
+	l.closeMessages()
+
+	l.mu.Lock()
+	subs := l.subs
+	l.subs = nil
+	l.mu.Unlock()
+	for _, h := range subs {
+		h.Disconnect()
+	}
+
 	go func() {
 		time.Sleep(10 * time.Millisecond)
 		C.pipelineUnref(l.cCtx)
@@ -140,6 +195,64 @@ func (l *GstLaunch) RegisterErrorCallback(f func(*GstLaunch, *gst.Element, strin
 	return nil
 }
 
+// RegisterStructuredErrorCallback registers error message handler callback
+// receiving the GError domain and code.
+// It can be used together with the callback registered by RegisterErrorCallback.
+func (l *GstLaunch) RegisterStructuredErrorCallback(f func(*GstLaunch, *Error)) error {
+	if l.closed.Load().(bool) {
+		return errClosed
+	}
+	l.mu.Lock()
+	l.cbStructuredError = f
+	l.mu.Unlock()
+	return nil
+}
+
+// RegisterWarningCallback registers warning message handler callback.
+func (l *GstLaunch) RegisterWarningCallback(f func(*GstLaunch, *Report)) error {
+	if l.closed.Load().(bool) {
+		return errClosed
+	}
+	l.mu.Lock()
+	l.cbWarning = f
+	l.mu.Unlock()
+	return nil
+}
+
+// RegisterInfoCallback registers info message handler callback.
+func (l *GstLaunch) RegisterInfoCallback(f func(*GstLaunch, *Report)) error {
+	if l.closed.Load().(bool) {
+		return errClosed
+	}
+	l.mu.Lock()
+	l.cbInfo = f
+	l.mu.Unlock()
+	return nil
+}
+
+// RegisterElementMessageCallback registers element specific message handler callback.
+// The callback receives the element posted the message, the structure name and
+// the fields converted in the same way as gst.Structure.Get.
+// Fields which can not be converted are omitted.
+// If names are given, only the messages having one of the structure names are handled.
+func (l *GstLaunch) RegisterElementMessageCallback(f func(*GstLaunch, *gst.Element, string, map[string]interface{}), names ...string) error {
+	if l.closed.Load().(bool) {
+		return errClosed
+	}
+	var filter map[string]bool
+	if len(names) > 0 {
+		filter = make(map[string]bool)
+		for _, n := range names {
+			filter[n] = true
+		}
+	}
+	l.mu.Lock()
+	l.cbElementMessage = f
+	l.elementMsgNames = filter
+	l.mu.Unlock()
+	return nil
+}
+
 // RegisterEOSCallback registers EOS message handler callback.
 func (l *GstLaunch) RegisterEOSCallback(f func(*GstLaunch)) error {
 	if l.closed.Load().(bool) {
@@ -162,6 +275,194 @@ func (l *GstLaunch) RegisterStateCallback(f func(*GstLaunch, gst.State, gst.Stat
 	return nil
 }
 
+// RegisterElementStateCallback registers state update message handler callback
+// receiving the state changes of every element in the pipeline including the pipeline itself.
+// It is useful to find the element which failed or is stuck in the state change.
+func (l *GstLaunch) RegisterElementStateCallback(f func(*GstLaunch, *gst.Element, gst.State, gst.State, gst.State)) error {
+	if l.closed.Load().(bool) {
+		return errClosed
+	}
+	l.mu.Lock()
+	l.cbElementState = f
+	l.mu.Unlock()
+	return nil
+}
+
+// RegisterSegmentDoneCallback registers segment-done message handler callback.
+// The message is posted instead of EOS when the segment seek reached the stop position.
+// The callback receives the format and the position of the end of the segment.
+func (l *GstLaunch) RegisterSegmentDoneCallback(f func(*GstLaunch, gst.Format, int64)) error {
+	if l.closed.Load().(bool) {
+		return errClosed
+	}
+	l.mu.Lock()
+	l.cbSegmentDone = f
+	l.mu.Unlock()
+	return nil
+}
+
+// RegisterTagCallback registers tag message handler callback.
+// The callback receives the element posted the message and the tag list.
+// The element is nil if the message is not posted by an element.
+func (l *GstLaunch) RegisterTagCallback(f func(*GstLaunch, *gst.Element, *gst.TagList)) error {
+	if l.closed.Load().(bool) {
+		return errClosed
+	}
+	l.mu.Lock()
+	l.cbTag = f
+	l.mu.Unlock()
+	return nil
+}
+
+// SubscribeDeepNotify registers a callback called when a property of
+// any element in the pipeline is changed.
+// If name is empty, changes of any property are notified.
+// Returned handler can be disconnected to unsubscribe and all subscriptions are
+// disconnected when the pipeline is closed.
+func (l *GstLaunch) SubscribeDeepNotify(name string, f func(*GstLaunch, *gst.Element, string, interface{})) (*gst.SignalHandler, error) {
+	if l.closed.Load().(bool) {
+		return nil, errClosed
+	}
+	h, err := l.pipeline().ConnectDeepNotify(name, func(_ *gst.Element, e *gst.Element, name string, val interface{}) {
+		if l.closed.Load().(bool) {
+			return
+		}
+		f(l, e, name, val)
+	})
+	if err != nil {
+		return nil, err
+	}
+	l.mu.Lock()
+	if l.closed.Load().(bool) {
+		l.mu.Unlock()
+		h.Disconnect()
+		return nil, errClosed
+	}
+	l.subs = append(l.subs, h)
+	l.mu.Unlock()
+	return h, nil
+}
+
+// Pipeline returns the pipeline.
+func (l *GstLaunch) Pipeline() (*gst.Pipeline, error) {
+	if l.closed.Load().(bool) {
+		return nil, errClosed
+	}
+	return gst.ToPipeline(l.pipeline())
+}
+
+// Position returns the current position of the pipeline.
+func (l *GstLaunch) Position() (time.Duration, error) {
+	if l.closed.Load().(bool) {
+		return 0, errClosed
+	}
+	return l.pipeline().Position()
+}
+
+// Duration returns the total duration of the stream played by the pipeline.
+func (l *GstLaunch) Duration() (time.Duration, error) {
+	if l.closed.Load().(bool) {
+		return 0, errClosed
+	}
+	return l.pipeline().Duration()
+}
+
+// QueryPosition returns the current position of the pipeline in the format.
+func (l *GstLaunch) QueryPosition(format gst.Format) (int64, error) {
+	if l.closed.Load().(bool) {
+		return 0, errClosed
+	}
+	return l.pipeline().QueryPosition(format)
+}
+
+// QueryDuration returns the total duration of the stream in the format.
+func (l *GstLaunch) QueryDuration(format gst.Format) (int64, error) {
+	if l.closed.Load().(bool) {
+		return 0, errClosed
+	}
+	return l.pipeline().QueryDuration(format)
+}
+
+// QueryConvert converts the value from the source format to the destination format.
+func (l *GstLaunch) QueryConvert(srcFormat gst.Format, srcVal int64, destFormat gst.Format) (int64, error) {
+	if l.closed.Load().(bool) {
+		return 0, errClosed
+	}
+	return l.pipeline().QueryConvert(srcFormat, srcVal, destFormat)
+}
+
+// QueryLatency returns the latency of the pipeline.
+func (l *GstLaunch) QueryLatency() (*gst.Latency, error) {
+	if l.closed.Load().(bool) {
+		return nil, errClosed
+	}
+	return l.pipeline().QueryLatency()
+}
+
+// QuerySeeking returns the seekable range of the pipeline in the format.
+func (l *GstLaunch) QuerySeeking(format gst.Format) (*gst.SeekingRange, error) {
+	if l.closed.Load().(bool) {
+		return nil, errClosed
+	}
+	return l.pipeline().QuerySeeking(format)
+}
+
+// Clock returns the clock which is used or will be used by the pipeline.
+func (l *GstLaunch) Clock() (*gst.Clock, error) {
+	p, err := l.Pipeline()
+	if err != nil {
+		return nil, err
+	}
+	return p.Clock()
+}
+
+// UseClock forces the pipeline to use the clock.
+// If c is nil, the pipeline runs without clock as fast as possible.
+// See gst.Pipeline.UseClock.
+func (l *GstLaunch) UseClock(c *gst.Clock) error {
+	p, err := l.Pipeline()
+	if err != nil {
+		return err
+	}
+	p.UseClock(c)
+	return nil
+}
+
+// AutoClock lets the pipeline select the clock automatically.
+// It reverts the effect of UseClock.
+func (l *GstLaunch) AutoClock() error {
+	p, err := l.Pipeline()
+	if err != nil {
+		return err
+	}
+	p.AutoClock()
+	return nil
+}
+
+// BaseTime returns the base time of the pipeline.
+func (l *GstLaunch) BaseTime() (time.Duration, error) {
+	if l.closed.Load().(bool) {
+		return 0, errClosed
+	}
+	return l.pipeline().BaseTime(), nil
+}
+
+// RunningTime returns the current running time of the pipeline.
+// Error is returned if the pipeline has no clock.
+// The pipeline has a clock in StatePaused and StatePlaying.
+func (l *GstLaunch) RunningTime() (time.Duration, error) {
+	if l.closed.Load().(bool) {
+		return 0, errClosed
+	}
+	return l.pipeline().RunningTime()
+}
+
+func (l *GstLaunch) pipeline() *gst.Element {
+	p := unsafe.Pointer(l.cCtx.pipeline)
+	C.refElement(p)
+	return gst.NewElement(p)
+}
+
 //export goCbEOS
 func goCbEOS(i C.int) {
 	cPointerMapMutex.RLock()
@@ -180,7 +481,7 @@ func goCbEOS(i C.int) {
 }
 
 //export goCbError
-func goCbError(i C.int, e unsafe.Pointer, msg *C.char, msgSize C.int, dbgInfo *C.char, dbgInfoSize C.int) {
+func goCbError(i C.int, e unsafe.Pointer, isElement C.int, domain *C.char, code C.int, path *C.char, msg *C.char, msgSize C.int, dbgInfo *C.char, dbgInfoSize C.int) {
 	cPointerMapMutex.RLock()
 	l, ok := cPointerMap[int(i)]
 	cPointerMapMutex.RUnlock()
@@ -190,6 +491,7 @@ func goCbError(i C.int, e unsafe.Pointer, msg *C.char, msgSize C.int, dbgInfo *C
 	}
 	l.mu.RLock()
 	cb := l.cbError
+	cbStructured := l.cbStructuredError
 	l.mu.RUnlock()
 
 	msgGo := C.GoStringN(msg, msgSize)
@@ -197,14 +499,108 @@ func goCbError(i C.int, e unsafe.Pointer, msg *C.char, msgSize C.int, dbgInfo *C
 	if dbgInfo != nil {
 		dbgInfoGo = C.GoStringN(dbgInfo, dbgInfoSize)
 	}
+	if cb == nil && cbStructured == nil {
+		log.Printf("Unhandled error message \"%s\":\n%s", msgGo, dbgInfoGo)
+		return
+	}
 	if cb != nil {
 		C.refElement(e)
 		cb(l, gst.NewElement(e), msgGo, dbgInfoGo)
-	} else {
-		log.Printf("Unhandled error message \"%s\":\n%s", msgGo, dbgInfoGo)
+	}
+	if cbStructured != nil {
+		domainGo := C.GoString(domain)
+		err := &Error{
+			Domain:      errorDomain(domainGo),
+			DomainQuark: domainGo,
+			Code:        int(code),
+			Message:     msgGo,
+			DebugInfo:   dbgInfoGo,
+		}
+		if path != nil {
+			err.SourcePath = C.GoString(path)
+		}
+		if isElement != 0 {
+			C.refElement(e)
+			err.Source = gst.NewElement(e)
+		}
+		cbStructured(l, err)
 	}
 }
 
+//export goCbMessage
+func goCbMessage(i C.int, msg unsafe.Pointer) {
+	cPointerMapMutex.RLock()
+	l, ok := cPointerMap[int(i)]
+	cPointerMapMutex.RUnlock()
+	if !ok {
+		log.Printf("Failed to map pointer from cgo func (message, %d)", int(i))
+		return
+	}
+	l.dispatchMessage(msg)
+}
+
+//export goCbBuffering
+func goCbBuffering(i C.int, msg unsafe.Pointer) {
+	cPointerMapMutex.RLock()
+	l, ok := cPointerMap[int(i)]
+	cPointerMapMutex.RUnlock()
+	if !ok {
+		log.Printf("Failed to map pointer from cgo func (buffering message, %d)", int(i))
+		return
+	}
+	l.handleBuffering((*C.GstMessage)(msg))
+}
+
+//export goCbElementMessage
+func goCbElementMessage(i C.int, msg unsafe.Pointer) {
+	cPointerMapMutex.RLock()
+	l, ok := cPointerMap[int(i)]
+	cPointerMapMutex.RUnlock()
+	if !ok {
+		log.Printf("Failed to map pointer from cgo func (element message, %d)", int(i))
+		return
+	}
+	l.handleElementMessage((*C.GstMessage)(msg))
+}
+
+//export goCbReport
+func goCbReport(i C.int, typ C.uint, e unsafe.Pointer, domain *C.char, code C.int, msg *C.char, dbgInfo *C.char) {
+	cPointerMapMutex.RLock()
+	l, ok := cPointerMap[int(i)]
+	cPointerMapMutex.RUnlock()
+	if !ok {
+		log.Printf("Failed to map pointer from cgo func (warning/info message, %d)", int(i))
+		return
+	}
+	isWarning := typ == C.GST_MESSAGE_WARNING
+	l.mu.RLock()
+	cb := l.cbInfo
+	if isWarning {
+		cb = l.cbWarning
+	}
+	l.mu.RUnlock()
+
+	r := &Report{
+		Message: C.GoString(msg),
+		Domain:  C.GoString(domain),
+		Code:    int(code),
+	}
+	if dbgInfo != nil {
+		r.DebugInfo = C.GoString(dbgInfo)
+	}
+	if cb == nil {
+		if isWarning {
+			log.Printf("Unhandled warning message \"%s\":\n%s", r.Message, r.DebugInfo)
+		}
+		return
+	}
+	if e != nil {
+		C.refElement(e)
+		r.Source = gst.NewElement(e)
+	}
+	cb(l, r)
+}
+
 //export goCbState
 func goCbState(i C.int, oldState, newState, pendingState C.uint) {
 	cPointerMapMutex.RLock()
@@ -217,6 +613,66 @@ func goCbState(i C.int, oldState, newState, pendingState C.uint) {
 	l.setState(gst.State(oldState), gst.State(newState), gst.State(pendingState))
 }
 
+//export goCbElementState
+func goCbElementState(i C.int, e unsafe.Pointer, oldState, newState, pendingState C.uint) {
+	cPointerMapMutex.RLock()
+	l, ok := cPointerMap[int(i)]
+	cPointerMapMutex.RUnlock()
+	if !ok {
+		log.Printf("Failed to map pointer from cgo func (element state message, %d)", int(i))
+		return
+	}
+	l.mu.RLock()
+	cb := l.cbElementState
+	l.mu.RUnlock()
+	if cb == nil {
+		return
+	}
+	C.refElement(e)
+	cb(l, gst.NewElement(e), gst.State(oldState), gst.State(newState), gst.State(pendingState))
+}
+
+//export goCbSegmentDone
+func goCbSegmentDone(i C.int, format C.int, position C.gint64) {
+	cPointerMapMutex.RLock()
+	l, ok := cPointerMap[int(i)]
+	cPointerMapMutex.RUnlock()
+	if !ok {
+		log.Printf("Failed to map pointer from cgo func (segment-done message, %d)", int(i))
+		return
+	}
+	l.mu.RLock()
+	cb := l.cbSegmentDone
+	l.mu.RUnlock()
+	if cb != nil {
+		cb(l, gst.Format(format), int64(position))
+	}
+}
+
+//export goCbTag
+func goCbTag(i C.int, e unsafe.Pointer, tags unsafe.Pointer) {
+	tl := gst.NewTagListFromPointer(tags)
+	cPointerMapMutex.RLock()
+	l, ok := cPointerMap[int(i)]
+	cPointerMapMutex.RUnlock()
+	if !ok {
+		log.Printf("Failed to map pointer from cgo func (tag message, %d)", int(i))
+		return
+	}
+	l.mu.RLock()
+	cb := l.cbTag
+	l.mu.RUnlock()
+	if cb == nil {
+		return
+	}
+	var elem *gst.Element
+	if e != nil {
+		C.refElement(e)
+		elem = gst.NewElement(e)
+	}
+	cb(l, elem, tl)
+}
+
 func (l *GstLaunch) setState(o, n, p gst.State) {
 	l.mu.RLock()
 	cb := l.cbState
@@ -240,7 +696,7 @@ func (l *GstLaunch) Start() error {
 	if l.closed.Load().(bool) {
 		return errClosed
 	}
-	C.pipelineStart(l.cCtx)
+	l.setTargetState(gst.StatePlaying, gst.StateChangeReturn(C.pipelineStart(l.cCtx)))
 	return nil
 }
 
@@ -249,12 +705,40 @@ func (l *GstLaunch) Kill() error {
 	if l.closed.Load().(bool) {
 		return errClosed
 	}
+	l.resetTargetState()
 	C.pipelineStop(l.cCtx)
 	// Transition to StateNULL is guaranteed to be synchronous and message is no longer reachable.
 	l.setState(gst.StateReady, gst.StateNull, gst.StateVoidPending)
 	return nil
 }
 
+// Seek seeks the pipeline to the position.
+// The pipeline must be started.
+func (l *GstLaunch) Seek(position time.Duration, flags gst.SeekFlags) error {
+	if l.closed.Load().(bool) {
+		return errClosed
+	}
+	return l.pipeline().Seek(position, flags)
+}
+
+// SeekFull seeks the pipeline with the playback rate and the start and stop positions.
+// See gst.Element.SeekFull for details.
+func (l *GstLaunch) SeekFull(rate float64, format gst.Format, flags gst.SeekFlags, startType gst.SeekType, start int64, stopType gst.SeekType, stop int64) error {
+	if l.closed.Load().(bool) {
+		return errClosed
+	}
+	return l.pipeline().SeekFull(rate, format, flags, startType, start, stopType, stop)
+}
+
+// Step steps the playback of the pipeline.
+// See gst.Element.Step for details.
+func (l *GstLaunch) Step(format gst.Format, amount uint64, rate float64, flush, intermediate bool) error {
+	if l.closed.Load().(bool) {
+		return errClosed
+	}
+	return l.pipeline().Step(format, amount, rate, flush, intermediate)
+}
+
 // Active returns true if the pipeline is playing.
 func (l *GstLaunch) Active() bool {
 	if l == nil {
@@ -39,13 +39,23 @@ typedef struct
 
 extern void goCbEOS(int id);
 extern void goCbError(
-    int id, void* src, char* msg, int msg_size, char* dbg_info, int dbg_info_size);
+    int id, void* src, int is_element, char* domain, int code, char* path,
+    char* msg, int msg_size, char* dbg_info, int dbg_info_size);
 extern void goCbState(
     int id, unsigned int old_state, unsigned int new_state, unsigned int pending_state);
+extern void goCbElementState(
+    int id, void* src, unsigned int old_state, unsigned int new_state, unsigned int pending_state);
+extern void goCbSegmentDone(int id, int format, gint64 position);
+extern void goCbTag(int id, void* src, void* tags);
+extern void goCbMessage(int id, void* msg);
+extern void goCbBuffering(int id, void* msg);
+extern void goCbElementMessage(int id, void* msg);
+extern void goCbReport(
+    int id, unsigned int type, void* src, char* domain, int code, char* msg, char* dbg_info);
 
-void init(char* exec_name);
 Context* create(const char* launch, int user_int);
-void pipelineStart(Context* ctx);
+Context* createFromPipeline(void* pipeline, int user_int);
+GstStateChangeReturn pipelineStart(Context* ctx);
 void pipelineStop(Context* ctx);
 void pipelineUnref(Context* ctx);
 void pipelineFree(Context* ctx);
@@ -15,16 +15,27 @@
 package gstlaunch
 
 import (
+	"os"
 	"reflect"
 	"sort"
+	"strings"
 	"sync"
 	"testing"
 	"time"
+	"unsafe"
 
 	gst "github.com/seqsense/sq-gst-go"
 	"github.com/seqsense/sq-gst-go/appsrc"
+	"github.com/seqsense/sq-gst-go/internal/dummyelement"
 )
 
+func TestMain(m *testing.M) {
+	if err := gst.Init(nil); err != nil {
+		panic(err)
+	}
+	os.Exit(m.Run())
+}
+
 func TestLaunch(t *testing.T) {
 	l := MustNew("audiotestsrc ! queue ! fakesink")
 
@@ -113,6 +124,61 @@ func TestLaunch_errorHandling(t *testing.T) {
 	l.Kill()
 }
 
+func TestLaunch_warningInfoHandling(t *testing.T) {
+	l := MustNew("fakesrc name=src ! fakesink")
+	defer l.Kill()
+
+	warnCh := make(chan *Report, 1)
+	infoCh := make(chan *Report, 1)
+	l.RegisterWarningCallback(func(l *GstLaunch, r *Report) {
+		warnCh <- r
+	})
+	l.RegisterInfoCallback(func(l *GstLaunch, r *Report) {
+		infoCh <- r
+	})
+	l.Start()
+
+	src, err := l.GetElement("src")
+	if err != nil {
+		t.Fatalf("failed to get fakesrc element: %v", err)
+	}
+	dummyelement.PostWarning(src.UnsafePointer(), "test warning", "warning debug")
+	dummyelement.PostInfo(src.UnsafePointer(), "test info", "info debug")
+
+	for _, tt := range []struct {
+		ch      chan *Report
+		message string
+		debug   string
+	}{
+		{warnCh, "test warning", "warning debug"},
+		{infoCh, "test info", "info debug"},
+	} {
+		select {
+		case r := <-tt.ch:
+			if r.Source == nil {
+				t.Fatal("source element must be set")
+			}
+			if name, _ := r.Source.GetProperty("name"); name != "src" {
+				t.Errorf("unexpected source %v, expected \"src\"", name)
+			}
+			if r.Message != tt.message {
+				t.Errorf("unexpected message %s, expected \"%s\"", r.Message, tt.message)
+			}
+			if r.Domain != "gst-core-error-quark" {
+				t.Errorf("unexpected domain %s", r.Domain)
+			}
+			if r.Code != 1 {
+				t.Errorf("unexpected code %d, expected 1", r.Code)
+			}
+			if !strings.Contains(r.DebugInfo, tt.debug) {
+				t.Errorf("unexpected debug info %s, expected to contain \"%s\"", r.DebugInfo, tt.debug)
+			}
+		case <-time.After(time.Second):
+			t.Errorf("expected %s, but timed-out", tt.message)
+		}
+	}
+}
+
 func TestLaunch_stateHandling(t *testing.T) {
 	l := MustNew("audiotestsrc ! queue ! fakesink")
 
@@ -150,6 +216,49 @@ L2:
 	}
 }
 
+func TestLaunch_elementStateHandling(t *testing.T) {
+	l := MustNew("audiotestsrc name=src ! queue name=q ! fakesink name=sink")
+	defer l.Kill()
+
+	p, err := l.Pipeline()
+	if err != nil {
+		t.Fatalf("failed to get pipeline: %v", err)
+	}
+
+	var mu sync.Mutex
+	var once sync.Once
+	playing := make(map[string]bool)
+	done := make(chan struct{})
+	l.RegisterElementStateCallback(func(l *GstLaunch, e *gst.Element, _, s, _ gst.State) {
+		if s != gst.StatePlaying {
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		playing[e.Name()] = true
+		if len(playing) == 4 {
+			once.Do(func() { close(done) })
+		}
+	})
+	l.Start()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		mu.Lock()
+		t.Errorf("expected all elements to be playing, got %v", playing)
+		mu.Unlock()
+		return
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	for _, name := range []string{"src", "q", "sink", p.Name()} {
+		if !playing[name] {
+			t.Errorf("state change of %s is not reported", name)
+		}
+	}
+}
+
 func TestGetElement(t *testing.T) {
 	l := MustNew("audiotestsrc ! queue name=named_elem ! queue ! fakesink")
 
@@ -238,3 +347,599 @@ func TestKill(t *testing.T) {
 		}
 	}
 }
+
+func TestSubscribeDeepNotify(t *testing.T) {
+	l := MustNew("fakesrc ! fakesink name=sink")
+	defer l.Kill()
+
+	type notification struct {
+		name  string
+		value interface{}
+	}
+	ch := make(chan notification, 10)
+	h, err := l.SubscribeDeepNotify("sync", func(l *GstLaunch, e *gst.Element, name string, val interface{}) {
+		elemName, _ := e.GetProperty("name")
+		if elemName != "sink" {
+			t.Errorf("unexpected notification source %v, expected \"sink\"", elemName)
+		}
+		ch <- notification{name: name, value: val}
+	})
+	if err != nil {
+		t.Fatalf("failed to subscribe deep-notify: %v", err)
+	}
+
+	sink, err := l.GetElement("sink")
+	if err != nil {
+		t.Fatalf("failed to get fakesink element: %v", err)
+	}
+	if err := sink.SetProperty("sync", true); err != nil {
+		t.Fatalf("failed to set property: %v", err)
+	}
+	select {
+	case n := <-ch:
+		if n.name != "sync" || n.value != true {
+			t.Errorf("unexpected notification %s=%v, expected sync=true", n.name, n.value)
+		}
+	case <-time.After(time.Millisecond * 100):
+		t.Error("expected deep-notify callback, but timed-out")
+	}
+
+	h.Disconnect()
+	if err := sink.SetProperty("sync", false); err != nil {
+		t.Fatalf("failed to set property: %v", err)
+	}
+	select {
+	case <-ch:
+		t.Error("unexpected deep-notify callback after unsubscribe")
+	case <-time.After(time.Millisecond * 100):
+	}
+}
+
+func TestConnect_handoff(t *testing.T) {
+	l := MustNew("audiotestsrc num-buffers=3 ! identity name=id signal-handoffs=true ! fakesink")
+	defer l.Kill()
+
+	id, err := l.GetElement("id")
+	if err != nil {
+		t.Fatalf("failed to get identity element: %v", err)
+	}
+	ch := make(chan struct{}, 10)
+	if _, err := id.Connect("handoff", func(e *gst.Element, buf unsafe.Pointer) {
+		if buf == nil {
+			t.Error("handoff buffer must not be nil")
+		}
+		ch <- struct{}{}
+	}); err != nil {
+		t.Fatalf("failed to connect handoff signal: %v", err)
+	}
+
+	l.Start()
+	for i := 0; i < 3; i++ {
+		select {
+		case <-ch:
+		case <-time.After(time.Second):
+			t.Fatal("expected handoff signal, but timed-out")
+		}
+	}
+}
+
+func TestNewFromPipeline(t *testing.T) {
+	p, err := gst.NewPipeline("")
+	if err != nil {
+		t.Fatalf("failed to create pipeline: %v", err)
+	}
+	src, err := gst.NewElementFromFactory("audiotestsrc", "src")
+	if err != nil {
+		t.Fatalf("failed to create element: %v", err)
+	}
+	if err := src.SetProperty("num-buffers", 1); err != nil {
+		t.Fatalf("failed to set property: %v", err)
+	}
+	sink, err := gst.NewElementFromFactory("fakesink", "")
+	if err != nil {
+		t.Fatalf("failed to create element: %v", err)
+	}
+	if err := p.Add(src, sink); err != nil {
+		t.Fatalf("failed to add elements: %v", err)
+	}
+	if err := src.Link(sink); err != nil {
+		t.Fatalf("failed to link elements: %v", err)
+	}
+
+	l, err := NewFromPipeline(p)
+	if err != nil {
+		t.Fatalf("failed to wrap pipeline: %v", err)
+	}
+	eosCh := make(chan struct{}, 1)
+	l.RegisterEOSCallback(func(l *GstLaunch) {
+		eosCh <- struct{}{}
+	})
+	if _, err := l.GetElement("src"); err != nil {
+		t.Errorf("failed to get element from wrapped pipeline: %v", err)
+	}
+
+	l.Start()
+	select {
+	case <-time.After(time.Second):
+		t.Errorf("expected EOS message, but timed-out")
+	case <-eosCh:
+	}
+	l.Kill()
+}
+
+func TestPipeline(t *testing.T) {
+	l := MustNew("audiotestsrc ! fakesink")
+	defer l.Kill()
+	if _, err := l.Pipeline(); err != nil {
+		t.Errorf("failed to get pipeline: %v", err)
+	}
+}
+
+func TestPadProbe(t *testing.T) {
+	l := MustNew("audiotestsrc num-buffers=5 ! identity name=id ! fakesink name=sink")
+	defer l.Kill()
+
+	id, err := l.GetElement("id")
+	if err != nil {
+		t.Fatalf("failed to get identity element: %v", err)
+	}
+	src, err := id.StaticPad("src")
+	if err != nil {
+		t.Fatalf("failed to get pad: %v", err)
+	}
+	sink, err := l.GetElement("sink")
+	if err != nil {
+		t.Fatalf("failed to get fakesink element: %v", err)
+	}
+	sinkPad, err := sink.StaticPad("sink")
+	if err != nil {
+		t.Fatalf("failed to get pad: %v", err)
+	}
+
+	var mu sync.Mutex
+	var nDropped, nReceived int
+	if _, err := src.AddProbe(gst.PadProbeTypeBuffer, func(p *gst.Pad, info *gst.PadProbeInfo) gst.PadProbeReturn {
+		if len(info.BufferBytes()) == 0 {
+			t.Error("buffer must not be empty")
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if nDropped < 2 {
+			nDropped++
+			return gst.PadProbeDrop
+		}
+		return gst.PadProbeOK
+	}); err != nil {
+		t.Fatalf("failed to add probe: %v", err)
+	}
+	if _, err := sinkPad.AddProbe(gst.PadProbeTypeBuffer, func(p *gst.Pad, info *gst.PadProbeInfo) gst.PadProbeReturn {
+		mu.Lock()
+		nReceived++
+		mu.Unlock()
+		return gst.PadProbeOK
+	}); err != nil {
+		t.Fatalf("failed to add probe: %v", err)
+	}
+	eosCh := make(chan struct{}, 1)
+	if _, err := sinkPad.AddProbe(gst.PadProbeTypeEventDownstream, func(p *gst.Pad, info *gst.PadProbeInfo) gst.PadProbeReturn {
+		if info.EventType() == gst.EventEOS {
+			eosCh <- struct{}{}
+			return gst.PadProbeRemove
+		}
+		return gst.PadProbeOK
+	}); err != nil {
+		t.Fatalf("failed to add probe: %v", err)
+	}
+
+	l.Start()
+	select {
+	case <-eosCh:
+	case <-time.After(time.Second):
+		t.Fatal("expected EOS event, but timed-out")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if nDropped != 2 {
+		t.Errorf("expected 2 dropped buffers, got %d", nDropped)
+	}
+	if nReceived != 3 {
+		t.Errorf("expected 3 buffers passed to the sink, got %d", nReceived)
+	}
+}
+
+func TestPadProbe_handled(t *testing.T) {
+	l := MustNew("audiotestsrc num-buffers=3 ! identity name=id ! fakesink name=sink")
+	defer l.Kill()
+
+	id, err := l.GetElement("id")
+	if err != nil {
+		t.Fatalf("failed to get identity element: %v", err)
+	}
+	src, err := id.StaticPad("src")
+	if err != nil {
+		t.Fatalf("failed to get pad: %v", err)
+	}
+
+	var mu sync.Mutex
+	var handled []*gst.Buffer
+	if _, err := src.AddProbe(gst.PadProbeTypeBuffer, func(p *gst.Pad, info *gst.PadProbeInfo) gst.PadProbeReturn {
+		mu.Lock()
+		defer mu.Unlock()
+		handled = append(handled, info.Buffer())
+		return gst.PadProbeHandled
+	}); err != nil {
+		t.Fatalf("failed to add probe: %v", err)
+	}
+	eosCh := make(chan struct{})
+	l.RegisterEOSCallback(func(l *GstLaunch) {
+		close(eosCh)
+	})
+
+	l.Start()
+	select {
+	case <-eosCh:
+	case <-time.After(time.Second):
+		t.Fatal("expected EOS, but timed-out")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(handled) != 3 {
+		t.Fatalf("expected 3 handled buffers, got %d", len(handled))
+	}
+	for _, b := range handled {
+		if b.Size() == 0 {
+			t.Error("buffer referenced in the callback must be kept")
+		}
+	}
+}
+
+func TestQuery(t *testing.T) {
+	l := MustNew("audiotestsrc samplesperbuffer=4410 num-buffers=10 ! audio/x-raw,rate=44100 ! fakesink sync=true")
+	defer l.Kill()
+
+	l.Start()
+	<-time.After(time.Millisecond * 300)
+
+	pos, err := l.Position()
+	if err != nil {
+		t.Fatalf("failed to query position: %v", err)
+	}
+	if pos <= 0 || pos > time.Second {
+		t.Errorf("unexpected position %v", pos)
+	}
+	n, err := l.QueryConvert(gst.FormatTime, int64(time.Second), gst.FormatDefault)
+	if err != nil {
+		t.Errorf("failed to query convert: %v", err)
+	} else if n != 44100 {
+		t.Errorf("1s must be converted to 44100 samples, but got %d", n)
+	}
+	lat, err := l.QueryLatency()
+	if err != nil {
+		t.Errorf("failed to query latency: %v", err)
+	} else if lat.Live {
+		t.Error("pipeline must not be live")
+	}
+	if _, err := l.QuerySeeking(gst.FormatTime); err != nil {
+		t.Errorf("failed to query seeking: %v", err)
+	}
+}
+
+func TestSeek(t *testing.T) {
+	l := MustNew("audiotestsrc ! fakesink sync=true")
+	defer l.Kill()
+
+	doneCh := make(chan int64, 1)
+	l.RegisterSegmentDoneCallback(func(l *GstLaunch, format gst.Format, pos int64) {
+		if format != gst.FormatTime {
+			t.Errorf("unexpected segment-done format %s", format)
+		}
+		doneCh <- pos
+	})
+	stateCh := make(chan gst.State, 100)
+	l.RegisterStateCallback(func(l *GstLaunch, _, s, _ gst.State) {
+		stateCh <- s
+	})
+
+	l.Start()
+L:
+	for {
+		select {
+		case s := <-stateCh:
+			if s == gst.StatePlaying {
+				break L
+			}
+		case <-time.After(time.Second):
+			t.Fatal("expected playing state, but timed-out")
+		}
+	}
+
+	if err := l.Seek(10*time.Second, gst.SeekFlagFlush|gst.SeekFlagAccurate); err != nil {
+		t.Fatalf("failed to seek: %v", err)
+	}
+	// Flushing seek completes asynchronously and the position may be
+	// the old one until the sink receives the new segment.
+	deadline := time.Now().Add(time.Second)
+	for {
+		pos, err := l.Position()
+		if err == nil && pos >= 10*time.Second {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("position must be after the seek position, but got %v (%v)", pos, err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if err := l.SeekFull(
+		1.0, gst.FormatTime, gst.SeekFlagFlush|gst.SeekFlagSegment,
+		gst.SeekTypeSet, 0, gst.SeekTypeSet, int64(100*time.Millisecond),
+	); err != nil {
+		t.Fatalf("failed to seek: %v", err)
+	}
+	select {
+	case pos := <-doneCh:
+		if pos != int64(100*time.Millisecond) {
+			t.Errorf("segment must be done at 100ms, but got %v", time.Duration(pos))
+		}
+	case <-time.After(time.Second):
+		t.Error("expected segment-done message, but timed-out")
+	}
+}
+
+func TestStep(t *testing.T) {
+	l := MustNew("audiotestsrc samplesperbuffer=441 ! audio/x-raw,rate=44100 ! fakesink sync=true")
+	defer l.Kill()
+
+	ch, err := l.Messages(&MessageFilter{Types: gst.MessageStepDone})
+	if err != nil {
+		t.Fatalf("failed to get message channel: %v", err)
+	}
+	p, err := l.Pipeline()
+	if err != nil {
+		t.Fatalf("failed to get pipeline: %v", err)
+	}
+	if _, err := p.SetState(gst.StatePaused); err != nil {
+		t.Fatalf("failed to pause pipeline: %v", err)
+	}
+	if _, _, _, err := p.GetState(time.Second); err != nil {
+		t.Fatalf("failed to get state: %v", err)
+	}
+	pos0, err := l.Position()
+	if err != nil {
+		t.Fatalf("failed to query position: %v", err)
+	}
+
+	// Step 10 buffers of 10ms.
+	if err := l.Step(gst.FormatBuffers, 10, 1.0, true, false); err != nil {
+		t.Fatalf("failed to step: %v", err)
+	}
+	select {
+	case <-ch:
+	case <-time.After(time.Second):
+		t.Fatal("expected step-done message, but timed-out")
+	}
+	// The sink reports the new position after prerolling the next buffer.
+	deadline := time.Now().Add(time.Second)
+	for {
+		pos, err := l.Position()
+		if err == nil && pos >= pos0+50*time.Millisecond {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("position must be moved from %v by the step, but got %v (%v)", pos0, pos, err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if err := l.Step(gst.FormatBuffers, 1, 0, true, false); err == nil {
+		t.Error("step with zero rate must fail")
+	}
+}
+
+func TestSendEvent(t *testing.T) {
+	l := MustNew("audiotestsrc is-live=true ! identity name=id ! fakesink name=sink")
+	defer l.Kill()
+
+	id, err := l.GetElement("id")
+	if err != nil {
+		t.Fatalf("failed to get identity element: %v", err)
+	}
+	idSink, err := id.StaticPad("sink")
+	if err != nil {
+		t.Fatalf("failed to get pad: %v", err)
+	}
+	idSrc, err := id.StaticPad("src")
+	if err != nil {
+		t.Fatalf("failed to get pad: %v", err)
+	}
+	sink, err := l.GetElement("sink")
+	if err != nil {
+		t.Fatalf("failed to get fakesink element: %v", err)
+	}
+	sinkPad, err := sink.StaticPad("sink")
+	if err != nil {
+		t.Fatalf("failed to get pad: %v", err)
+	}
+
+	downCh := make(chan string, 10)
+	if _, err := sinkPad.AddProbe(gst.PadProbeTypeEventDownstream, func(p *gst.Pad, info *gst.PadProbeInfo) gst.PadProbeReturn {
+		if info.EventType() == gst.EventCustomDownstream {
+			s, err := info.Event().Structure()
+			if err != nil {
+				t.Errorf("failed to get event structure: %v", err)
+				return gst.PadProbeOK
+			}
+			downCh <- s.Name()
+		}
+		return gst.PadProbeOK
+	}); err != nil {
+		t.Fatalf("failed to add probe: %v", err)
+	}
+	upCh := make(chan string, 10)
+	if _, err := idSrc.AddProbe(gst.PadProbeTypeEventUpstream, func(p *gst.Pad, info *gst.PadProbeInfo) gst.PadProbeReturn {
+		if info.EventType() == gst.EventCustomUpstream {
+			s, err := info.Event().Structure()
+			if err != nil {
+				t.Errorf("failed to get event structure: %v", err)
+				return gst.PadProbeOK
+			}
+			upCh <- s.Name()
+		}
+		return gst.PadProbeOK
+	}); err != nil {
+		t.Fatalf("failed to add probe: %v", err)
+	}
+	eosCh := make(chan struct{}, 1)
+	l.RegisterEOSCallback(func(l *GstLaunch) {
+		eosCh <- struct{}{}
+	})
+
+	l.Start()
+	<-time.After(time.Millisecond * 100)
+
+	s, err := gst.NewStructure("test-event")
+	if err != nil {
+		t.Fatalf("failed to create structure: %v", err)
+	}
+	ev, err := gst.NewCustomEvent(gst.EventCustomDownstream, s)
+	if err != nil {
+		t.Fatalf("failed to create event: %v", err)
+	}
+	if !idSink.SendEvent(ev) {
+		t.Error("custom downstream event must be handled")
+	}
+	select {
+	case name := <-downCh:
+		if name != "test-event" {
+			t.Errorf("unexpected event structure %s", name)
+		}
+	case <-time.After(time.Second):
+		t.Error("expected custom downstream event, but timed-out")
+	}
+
+	sink.SendEvent(gst.NewUpstreamForceKeyUnitEvent(-1, true, 1))
+	select {
+	case name := <-upCh:
+		if name != "GstForceKeyUnit" {
+			t.Errorf("unexpected event structure %s", name)
+		}
+	case <-time.After(time.Second):
+		t.Error("expected force-key-unit event, but timed-out")
+	}
+
+	p, err := l.Pipeline()
+	if err != nil {
+		t.Fatalf("failed to get pipeline: %v", err)
+	}
+	if !p.SendEvent(gst.NewEOSEvent()) {
+		t.Error("EOS event must be handled")
+	}
+	select {
+	case <-eosCh:
+	case <-time.After(time.Second):
+		t.Error("expected EOS message, but timed-out")
+	}
+}
+
+func TestTagCallback(t *testing.T) {
+	l := MustNew("audiotestsrc is-live=true ! fakesink name=sink")
+	defer l.Kill()
+
+	ch := make(chan string, 10)
+	l.RegisterTagCallback(func(l *GstLaunch, e *gst.Element, tags *gst.TagList) {
+		if title, ok := tags.GetString(gst.TagTitle); ok {
+			ch <- title
+		}
+	})
+
+	l.Start()
+	<-time.After(time.Millisecond * 100)
+
+	sink, err := l.GetElement("sink")
+	if err != nil {
+		t.Fatalf("failed to get fakesink element: %v", err)
+	}
+	pad, err := sink.StaticPad("sink")
+	if err != nil {
+		t.Fatalf("failed to get pad: %v", err)
+	}
+	tags := gst.NewTagList()
+	if err := tags.Add(gst.TagMergeReplace, gst.TagTitle, "test title"); err != nil {
+		t.Fatalf("failed to add tag: %v", err)
+	}
+	pad.SendEvent(gst.NewTagEvent(tags))
+
+	select {
+	case title := <-ch:
+		if title != "test title" {
+			t.Errorf("unexpected title %s", title)
+		}
+	case <-time.After(time.Second):
+		t.Error("expected tag message, but timed-out")
+	}
+}
+
+func TestClock(t *testing.T) {
+	l := MustNew("audiotestsrc ! fakesink sync=true")
+	defer l.Kill()
+
+	c, err := gst.NewSystemClock(gst.ClockTypeRealtime)
+	if err != nil {
+		t.Fatalf("failed to create clock: %v", err)
+	}
+	if err := l.UseClock(c); err != nil {
+		t.Fatalf("failed to set clock: %v", err)
+	}
+	l.Start()
+	<-time.After(time.Millisecond * 200)
+
+	pc, err := l.Clock()
+	if err != nil {
+		t.Fatalf("failed to get clock: %v", err)
+	}
+	if pc.UnsafePointer() != c.UnsafePointer() {
+		t.Error("pipeline must use the forced clock")
+	}
+	bt, err := l.BaseTime()
+	if err != nil {
+		t.Fatalf("failed to get base time: %v", err)
+	}
+	rt, err := l.RunningTime()
+	if err != nil {
+		t.Fatalf("failed to get running time: %v", err)
+	}
+	if rt < 100*time.Millisecond || time.Second < rt {
+		t.Errorf("unexpected running time %v", rt)
+	}
+	if now := time.Duration(time.Now().UnixNano()); now-(bt+rt) > time.Second {
+		t.Errorf("base time %v plus running time %v must be close to the wall clock %v", bt, rt, now)
+	}
+}
+
+func TestAutoClock(t *testing.T) {
+	l := MustNew("audiotestsrc ! fakesink sync=true")
+	defer l.Kill()
+
+	c, err := gst.NewSystemClock(gst.ClockTypeRealtime)
+	if err != nil {
+		t.Fatalf("failed to create clock: %v", err)
+	}
+	if err := l.UseClock(c); err != nil {
+		t.Fatalf("failed to set clock: %v", err)
+	}
+	if err := l.AutoClock(); err != nil {
+		t.Fatalf("failed to reset clock: %v", err)
+	}
+	l.Start()
+	<-time.After(time.Millisecond * 200)
+
+	pc, err := l.Clock()
+	if err != nil {
+		t.Fatalf("failed to get clock: %v", err)
+	}
+	if pc.Name() == "" {
+		t.Error("automatically selected clock must have a name")
+	}
+}
@@ -0,0 +1,501 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gstlaunch
+
+// #cgo pkg-config: gobject-2.0 gstreamer-1.0
+// #include <gst/gst.h>
+// static void* messageSourceElement(GstMessage* msg)
+// {
+//   if (!GST_IS_ELEMENT(GST_MESSAGE_SRC(msg)))
+//     return NULL;
+//   return gst_object_ref(GST_MESSAGE_SRC(msg));
+// }
+// static gchar* messageSourcePath(GstMessage* msg)
+// {
+//   if (GST_MESSAGE_SRC(msg) == NULL)
+//     return NULL;
+//   return gst_object_get_path_string(GST_MESSAGE_SRC(msg));
+// }
+// static GError* parseMessageGError(GstMessage* msg, gchar** dbg_info)
+// {
+//   GError* err = NULL;
+//   switch (GST_MESSAGE_TYPE(msg))
+//   {
+//     case GST_MESSAGE_ERROR:
+//       gst_message_parse_error(msg, &err, dbg_info);
+//       break;
+//     case GST_MESSAGE_WARNING:
+//       gst_message_parse_warning(msg, &err, dbg_info);
+//       break;
+//     default:
+//       gst_message_parse_info(msg, &err, dbg_info);
+//       break;
+//   }
+//   return err;
+// }
+// static GstStructure* copyMessageStructure(GstMessage* msg)
+// {
+//   const GstStructure* s = gst_message_get_structure(msg);
+//   if (s == NULL)
+//     return NULL;
+//   return gst_structure_copy(s);
+// }
+import "C"
+
+import (
+	"log"
+	"sync"
+	"time"
+	"unsafe"
+
+	gst "github.com/seqsense/sq-gst-go"
+)
+
+// Message is a typed bus message of the pipeline.
+// The concrete type is one of *EOSMessage, *ErrorMessage, *WarningMessage,
+// *InfoMessage, *StateChangedMessage, *TagMessage, *BufferingMessage,
+// *ElementMessage, *LatencyMessage, *SegmentDoneMessage, *AsyncDoneMessage,
+// *StreamStartMessage or *GenericMessage for the other types.
+type Message interface {
+	// Type returns the type of the message.
+	Type() gst.MessageType
+	// Source returns the element which posted the message.
+	// It is nil if the message is not posted by an element.
+	Source() *gst.Element
+	// SourcePath returns the full path of the object which posted the message.
+	SourcePath() string
+}
+
+type messageHeader struct {
+	typ     gst.MessageType
+	src     *gst.Element
+	srcPath string
+}
+
+func (h *messageHeader) Type() gst.MessageType {
+	return h.typ
+}
+
+func (h *messageHeader) Source() *gst.Element {
+	return h.src
+}
+
+func (h *messageHeader) SourcePath() string {
+	return h.srcPath
+}
+
+// EOSMessage is posted when the pipeline reached the end of the stream.
+type EOSMessage struct {
+	messageHeader
+}
+
+// ErrorMessage is posted when an element raised an error.
+type ErrorMessage struct {
+	messageHeader
+	Err *Error
+}
+
+// WarningMessage is posted when an element raised a warning.
+type WarningMessage struct {
+	messageHeader
+	Report *Report
+}
+
+// InfoMessage is posted when an element reported an information.
+type InfoMessage struct {
+	messageHeader
+	Report *Report
+}
+
+// StateChangedMessage is posted when the state of an element is changed.
+type StateChangedMessage struct {
+	messageHeader
+	Old, New, Pending gst.State
+}
+
+// TagMessage is posted when an element found tags in the stream.
+type TagMessage struct {
+	messageHeader
+	Tags *gst.TagList
+}
+
+// BufferingMessage is posted by the elements buffering the stream.
+type BufferingMessage struct {
+	messageHeader
+	Buffering
+}
+
+// ElementMessage is an element specific message.
+type ElementMessage struct {
+	messageHeader
+	Structure *gst.Structure
+}
+
+// LatencyMessage is posted when the latency of the pipeline should be recalculated.
+type LatencyMessage struct {
+	messageHeader
+}
+
+// SegmentDoneMessage is posted when the segment seek reached the stop position.
+type SegmentDoneMessage struct {
+	messageHeader
+	Format   gst.Format
+	Position int64
+}
+
+// AsyncDoneMessage is posted when the asynchronous state change is completed.
+type AsyncDoneMessage struct {
+	messageHeader
+	// RunningTime is the desired running time or negative if not set.
+	RunningTime time.Duration
+}
+
+// StreamStartMessage is posted when the stream is started.
+type StreamStartMessage struct {
+	messageHeader
+}
+
+// GenericMessage is a message of the other types.
+type GenericMessage struct {
+	messageHeader
+	// Structure is the copy of the message structure.
+	// It is nil if the message has no structure.
+	Structure *gst.Structure
+}
+
+// OverflowPolicy specifies the behavior when the message channel is full.
+type OverflowPolicy int
+
+// OverflowPolicy values.
+const (
+	// OverflowDropNewest drops the incoming message.
+	OverflowDropNewest OverflowPolicy = iota
+	// OverflowDropOldest drops the oldest message in the channel.
+	OverflowDropOldest
+	// OverflowBlock blocks the bus message handling until the message is received.
+	// Note that it also blocks the message handling of the other pipelines.
+	OverflowBlock
+)
+
+// DefaultMessageBufferSize is the channel buffer size used if
+// MessageFilter.BufferSize is zero.
+const DefaultMessageBufferSize = 32
+
+// MessageFilter specifies the messages to be received.
+type MessageFilter struct {
+	// Types is a mask of the message types.
+	// All types are received if zero.
+	Types gst.MessageType
+	// Sources is a list of the source elements.
+	// Messages from any source are received if empty.
+	Sources []*gst.Element
+	// BufferSize is the buffer size of the channel.
+	// DefaultMessageBufferSize is used if zero.
+	BufferSize int
+	// Overflow is the policy applied when the channel is full.
+	Overflow OverflowPolicy
+}
+
+type messageSubscription struct {
+	types    gst.MessageType
+	sources  []unsafe.Pointer
+	overflow OverflowPolicy
+
+	ch     chan Message
+	done   chan struct{}
+	mu     sync.Mutex
+	closed bool
+}
+
+func (s *messageSubscription) match(typ gst.MessageType, src unsafe.Pointer) bool {
+	if !typ.Matches(s.types) {
+		return false
+	}
+	if len(s.sources) == 0 {
+		return true
+	}
+	for _, p := range s.sources {
+		if p == src {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *messageSubscription) send(m Message) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+	switch s.overflow {
+	case OverflowBlock:
+		select {
+		case s.ch <- m:
+		case <-s.done:
+		}
+	case OverflowDropOldest:
+		for {
+			select {
+			case s.ch <- m:
+				return
+			default:
+			}
+			select {
+			case <-s.ch:
+			default:
+			}
+		}
+	default:
+		select {
+		case s.ch <- m:
+		default:
+		}
+	}
+}
+
+func (s *messageSubscription) close() {
+	close(s.done)
+	s.mu.Lock()
+	s.closed = true
+	close(s.ch)
+	s.mu.Unlock()
+}
+
+// Messages returns a channel receiving the bus messages matched to the filter.
+// If filter is nil, all messages are received.
+// The channel is closed when the pipeline is closed by Kill.
+func (l *GstLaunch) Messages(filter *MessageFilter) (<-chan Message, error) {
+	if l.closed.Load().(bool) {
+		return nil, errClosed
+	}
+	if filter == nil {
+		filter = &MessageFilter{}
+	}
+	s := &messageSubscription{
+		types:    filter.Types,
+		overflow: filter.Overflow,
+		done:     make(chan struct{}),
+	}
+	if s.types == 0 {
+		s.types = gst.MessageAny
+	}
+	for _, e := range filter.Sources {
+		s.sources = append(s.sources, e.UnsafePointer())
+	}
+	size := filter.BufferSize
+	if size <= 0 {
+		size = DefaultMessageBufferSize
+	}
+	s.ch = make(chan Message, size)
+
+	l.mu.Lock()
+	if l.msgClosed {
+		l.mu.Unlock()
+		return nil, errClosed
+	}
+	l.msgSubs = append(l.msgSubs, s)
+	l.mu.Unlock()
+	return s.ch, nil
+}
+
+func (l *GstLaunch) closeMessages() {
+	l.mu.Lock()
+	subs := l.msgSubs
+	l.msgSubs = nil
+	l.msgClosed = true
+	l.mu.Unlock()
+	for _, s := range subs {
+		s.close()
+	}
+}
+
+func (l *GstLaunch) dispatchMessage(p unsafe.Pointer) {
+	l.mu.RLock()
+	subs := l.msgSubs
+	l.mu.RUnlock()
+	if len(subs) == 0 {
+		return
+	}
+	msg := (*C.GstMessage)(p)
+	typ := gst.MessageType(msg._type)
+	src := unsafe.Pointer(msg.src)
+
+	var matched []*messageSubscription
+	for _, s := range subs {
+		if s.match(typ, src) {
+			matched = append(matched, s)
+		}
+	}
+	if len(matched) == 0 {
+		return
+	}
+	m := newMessage(msg)
+	for _, s := range matched {
+		s.send(m)
+	}
+}
+
+func newMessage(msg *C.GstMessage) Message {
+	h := messageHeader{
+		typ: gst.MessageType(msg._type),
+		src: messageSource(msg),
+	}
+	if path := C.messageSourcePath(msg); path != nil {
+		h.srcPath = C.GoString(path)
+		C.g_free(C.gpointer(path))
+	}
+
+	switch h.typ {
+	case gst.MessageEOS:
+		return &EOSMessage{messageHeader: h}
+	case gst.MessageError:
+		domain, code, message, dbgInfo := parseGError(msg)
+		return &ErrorMessage{
+			messageHeader: h,
+			Err: &Error{
+				Domain:      errorDomain(domain),
+				DomainQuark: domain,
+				Code:        code,
+				Source:      h.src,
+				SourcePath:  h.srcPath,
+				Message:     message,
+				DebugInfo:   dbgInfo,
+			},
+		}
+	case gst.MessageWarning, gst.MessageInfo:
+		r := &Report{Source: h.src}
+		r.Domain, r.Code, r.Message, r.DebugInfo = parseGError(msg)
+		if h.typ == gst.MessageWarning {
+			return &WarningMessage{messageHeader: h, Report: r}
+		}
+		return &InfoMessage{messageHeader: h, Report: r}
+	case gst.MessageStateChanged:
+		var oldState, newState, pendingState C.GstState
+		C.gst_message_parse_state_changed(msg, &oldState, &newState, &pendingState)
+		return &StateChangedMessage{
+			messageHeader: h,
+			Old:           gst.State(oldState),
+			New:           gst.State(newState),
+			Pending:       gst.State(pendingState),
+		}
+	case gst.MessageTag:
+		var tags *C.GstTagList
+		C.gst_message_parse_tag(msg, &tags)
+		return &TagMessage{messageHeader: h, Tags: gst.NewTagListFromPointer(unsafe.Pointer(tags))}
+	case gst.MessageBuffering:
+		return &BufferingMessage{messageHeader: h, Buffering: *parseBuffering(msg)}
+	case gst.MessageElement:
+		return &ElementMessage{messageHeader: h, Structure: messageStructure(msg)}
+	case gst.MessageLatency:
+		return &LatencyMessage{messageHeader: h}
+	case gst.MessageSegmentDone:
+		var format C.GstFormat
+		var position C.gint64
+		C.gst_message_parse_segment_done(msg, &format, &position)
+		return &SegmentDoneMessage{messageHeader: h, Format: gst.Format(format), Position: int64(position)}
+	case gst.MessageAsyncDone:
+		var runningTime C.GstClockTime
+		C.gst_message_parse_async_done(msg, &runningTime)
+		return &AsyncDoneMessage{messageHeader: h, RunningTime: time.Duration(int64(runningTime))}
+	case gst.MessageStreamStart:
+		return &StreamStartMessage{messageHeader: h}
+	}
+	return &GenericMessage{messageHeader: h, Structure: messageStructure(msg)}
+}
+
+func (l *GstLaunch) handleElementMessage(msg *C.GstMessage) {
+	l.mu.RLock()
+	cb := l.cbElementMessage
+	names := l.elementMsgNames
+	l.mu.RUnlock()
+	if cb == nil {
+		return
+	}
+	s := messageStructure(msg)
+	if s == nil {
+		return
+	}
+	name := s.Name()
+	if names != nil && !names[name] {
+		return
+	}
+	fields := make(map[string]interface{})
+	for _, f := range s.Fields() {
+		v, err := s.Get(f)
+		if err != nil {
+			log.Printf("Failed to convert field %s of element message %s: %v", f, name, err)
+			continue
+		}
+		fields[f] = v
+	}
+	cb(l, messageSource(msg), name, fields)
+}
+
+func messageSource(msg *C.GstMessage) *gst.Element {
+	e := C.messageSourceElement(msg)
+	if e == nil {
+		return nil
+	}
+	return gst.NewElement(e)
+}
+
+func parseBuffering(msg *C.GstMessage) *Buffering {
+	var percent C.gint
+	var mode C.GstBufferingMode
+	var avgIn, avgOut C.gint
+	var left C.gint64
+	C.gst_message_parse_buffering(msg, &percent)
+	C.gst_message_parse_buffering_stats(msg, &mode, &avgIn, &avgOut, &left)
+	b := &Buffering{
+		Percent: int(percent),
+		Mode:    gst.BufferingMode(mode),
+		AvgIn:   int(avgIn),
+		AvgOut:  int(avgOut),
+		Left:    -1,
+	}
+	if left >= 0 {
+		b.Left = time.Duration(left) * time.Millisecond
+	}
+	return b
+}
+
+// parseGError returns the domain, code, message and debug info of the error,
+// warning or info message.
+// Zero values are returned if the message has no GError.
+func parseGError(msg *C.GstMessage) (string, int, string, string) {
+	var dbgInfo *C.gchar
+	err := C.parseMessageGError(msg, &dbgInfo)
+	defer C.g_free(C.gpointer(dbgInfo))
+	if err == nil {
+		return "", 0, "", ""
+	}
+	defer C.g_error_free(err)
+
+	var dbgInfoGo string
+	if dbgInfo != nil {
+		dbgInfoGo = C.GoString(dbgInfo)
+	}
+	domain := C.GoString(C.g_quark_to_string(err.domain))
+	return domain, int(err.code), C.GoString(err.message), dbgInfoGo
+}
+
+func messageStructure(msg *C.GstMessage) *gst.Structure {
+	s := C.copyMessageStructure(msg)
+	if s == nil {
+		return nil
+	}
+	return gst.NewStructureFromPointer(unsafe.Pointer(s))
+}
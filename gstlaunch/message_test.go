@@ -0,0 +1,334 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gstlaunch
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	gst "github.com/seqsense/sq-gst-go"
+	"github.com/seqsense/sq-gst-go/internal/dummyelement"
+)
+
+func TestMessages(t *testing.T) {
+	l := MustNew("audiotestsrc num-buffers=5 ! fakesink name=sink")
+
+	ch, err := l.Messages(&MessageFilter{
+		Types: gst.MessageEOS | gst.MessageStateChanged,
+	})
+	if err != nil {
+		t.Fatalf("failed to get message channel: %v", err)
+	}
+	l.Start()
+
+	var nStateChanged int
+L_RECV:
+	for {
+		select {
+		case m := <-ch:
+			switch msg := m.(type) {
+			case *StateChangedMessage:
+				if msg.Type() != gst.MessageStateChanged {
+					t.Errorf("unexpected type %s", msg.Type())
+				}
+				nStateChanged++
+			case *EOSMessage:
+				break L_RECV
+			default:
+				t.Errorf("unexpected message %T", m)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("expected EOS message, but timed-out")
+		}
+	}
+	if nStateChanged == 0 {
+		t.Error("expected state-changed messages")
+	}
+
+	l.Kill()
+	timeout := time.After(time.Second)
+L_CLOSE:
+	for {
+		select {
+		case _, ok := <-ch:
+			if !ok {
+				break L_CLOSE
+			}
+		case <-timeout:
+			t.Fatal("channel must be closed after Kill")
+		}
+	}
+	if _, err := l.Messages(nil); err != errClosed {
+		t.Errorf("expected errClosed, got %v", err)
+	}
+}
+
+func TestMessages_sourceFilter(t *testing.T) {
+	l := MustNew("audiotestsrc ! fakesink name=sink")
+	defer l.Kill()
+
+	sink, err := l.GetElement("sink")
+	if err != nil {
+		t.Fatalf("failed to get fakesink element: %v", err)
+	}
+	ch, err := l.Messages(&MessageFilter{
+		Types:   gst.MessageStateChanged,
+		Sources: []*gst.Element{sink},
+	})
+	if err != nil {
+		t.Fatalf("failed to get message channel: %v", err)
+	}
+	l.Start()
+
+	for {
+		select {
+		case m := <-ch:
+			if m.Source() == nil {
+				t.Fatal("source element must be set")
+			}
+			if name, _ := m.Source().GetProperty("name"); name != "sink" {
+				t.Fatalf("unexpected source %v", name)
+			}
+			if msg := m.(*StateChangedMessage); msg.New == gst.StatePlaying {
+				return
+			}
+		case <-time.After(time.Second):
+			t.Fatal("expected state-changed message to PLAYING, but timed-out")
+		}
+	}
+}
+
+func TestMessages_payload(t *testing.T) {
+	l := MustNew("audiotestsrc ! fakesink name=sink")
+	defer l.Kill()
+	l.RegisterErrorCallback(func(*GstLaunch, *gst.Element, string, string) {})
+
+	sink, err := l.GetElement("sink")
+	if err != nil {
+		t.Fatalf("failed to get fakesink element: %v", err)
+	}
+	ch, err := l.Messages(&MessageFilter{
+		Types: gst.MessageError | gst.MessageWarning | gst.MessageInfo |
+			gst.MessageTag | gst.MessageBuffering | gst.MessageElement,
+		Sources: []*gst.Element{sink},
+	})
+	if err != nil {
+		t.Fatalf("failed to get message channel: %v", err)
+	}
+	l.Start()
+
+	p := sink.UnsafePointer()
+	dummyelement.PostError(p, "test error", "error debug")
+	dummyelement.PostWarning(p, "test warning", "warning debug")
+	dummyelement.PostInfo(p, "test info", "info debug")
+	if !dummyelement.PostTag(p, "taglist, title=(string)test-title") {
+		t.Fatal("failed to post tag message")
+	}
+	dummyelement.PostBuffering(p, 50, 1000)
+	if !dummyelement.PostElementMessage(p, "test-message, count=(int)3") {
+		t.Fatal("failed to post element message")
+	}
+
+	checkReport := func(r *Report, msg, debug string) {
+		t.Helper()
+		if r.Message != msg || r.DebugInfo != debug {
+			t.Errorf("expected %q (%q), got %q (%q)", msg, debug, r.Message, r.DebugInfo)
+		}
+		if r.Domain != "gst-core-error-quark" {
+			t.Errorf("unexpected domain %s", r.Domain)
+		}
+		if r.Source == nil || r.Source.Name() != "sink" {
+			t.Error("source must be the sink element")
+		}
+	}
+
+	received := make(map[gst.MessageType]bool)
+	for len(received) < 6 {
+		var m Message
+		select {
+		case m = <-ch:
+		case <-time.After(time.Second):
+			t.Fatalf("expected 6 message types, but timed-out after %v", received)
+		}
+		switch msg := m.(type) {
+		case *ErrorMessage:
+			if !errors.Is(msg.Err, ErrResourceNotFound) {
+				t.Errorf("expected ErrResourceNotFound, got %s error %d", msg.Err.Domain, msg.Err.Code)
+			}
+			if msg.Err.Message != "test error" || msg.Err.DebugInfo != "error debug" {
+				t.Errorf("unexpected error %q (%q)", msg.Err.Message, msg.Err.DebugInfo)
+			}
+			if msg.Err.SourcePath != msg.SourcePath() || msg.Err.Source == nil {
+				t.Error("error source must be set")
+			}
+		case *WarningMessage:
+			checkReport(msg.Report, "test warning", "warning debug")
+		case *InfoMessage:
+			checkReport(msg.Report, "test info", "info debug")
+		case *TagMessage:
+			if title, ok := msg.Tags.GetString("title"); !ok || title != "test-title" {
+				t.Errorf("unexpected title %q", title)
+			}
+		case *BufferingMessage:
+			if msg.Percent != 50 || msg.Mode != gst.BufferingStream || msg.Left != time.Second {
+				t.Errorf("unexpected buffering status %+v", msg.Buffering)
+			}
+		case *ElementMessage:
+			if msg.Structure.Name() != "test-message" {
+				t.Errorf("unexpected structure name %s", msg.Structure.Name())
+			}
+			if v, err := msg.Structure.Get("count"); err != nil || v != 3 {
+				t.Errorf("unexpected count field %v (%v)", v, err)
+			}
+		default:
+			t.Fatalf("unexpected message %T", m)
+		}
+		received[m.Type()] = true
+	}
+}
+
+func TestMessages_overflow(t *testing.T) {
+	testCases := map[string]struct {
+		overflow OverflowPolicy
+		expected gst.MessageType
+	}{
+		"DropNewest": {OverflowDropNewest, gst.MessageEOS},
+		"DropOldest": {OverflowDropOldest, gst.MessageLatency},
+	}
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			s := &messageSubscription{
+				types:    gst.MessageAny,
+				overflow: tt.overflow,
+				ch:       make(chan Message, 1),
+				done:     make(chan struct{}),
+			}
+			s.send(&EOSMessage{messageHeader{typ: gst.MessageEOS}})
+			s.send(&LatencyMessage{messageHeader{typ: gst.MessageLatency}})
+			s.close()
+
+			var types []gst.MessageType
+			for m := range s.ch {
+				types = append(types, m.Type())
+			}
+			if len(types) != 1 || types[0] != tt.expected {
+				t.Errorf("expected [%s], got %v", tt.expected, types)
+			}
+		})
+	}
+
+	t.Run("Block", func(t *testing.T) {
+		s := &messageSubscription{
+			types:    gst.MessageAny,
+			overflow: OverflowBlock,
+			ch:       make(chan Message, 1),
+			done:     make(chan struct{}),
+		}
+		s.send(&EOSMessage{messageHeader{typ: gst.MessageEOS}})
+		sent := make(chan struct{})
+		go func() {
+			s.send(&LatencyMessage{messageHeader{typ: gst.MessageLatency}})
+			close(sent)
+		}()
+		select {
+		case <-sent:
+			t.Fatal("send must be blocked")
+		case <-time.After(50 * time.Millisecond):
+		}
+		<-s.ch
+		select {
+		case <-sent:
+		case <-time.After(time.Second):
+			t.Fatal("send must be unblocked")
+		}
+		if m := <-s.ch; m.Type() != gst.MessageLatency {
+			t.Errorf("unexpected message %s", m.Type())
+		}
+	})
+}
+
+func TestElementMessageCallback(t *testing.T) {
+	testCases := map[string]struct {
+		names    []string
+		received bool
+	}{
+		"NoFilter":   {nil, true},
+		"Matched":    {[]string{"other", "test-message"}, true},
+		"NotMatched": {[]string{"other"}, false},
+	}
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			l := MustNew("audiotestsrc ! fakesink name=sink")
+			defer l.Kill()
+
+			type elementMessage struct {
+				src    string
+				name   string
+				fields map[string]interface{}
+			}
+			ch := make(chan elementMessage, 1)
+			err := l.RegisterElementMessageCallback(func(l *GstLaunch, e *gst.Element, name string, fields map[string]interface{}) {
+				var src string
+				if e != nil {
+					src = e.Name()
+				}
+				select {
+				case ch <- elementMessage{src: src, name: name, fields: fields}:
+				default:
+				}
+			}, tt.names...)
+			if err != nil {
+				t.Fatalf("failed to register callback: %v", err)
+			}
+			l.Start()
+
+			sink, err := l.GetElement("sink")
+			if err != nil {
+				t.Fatalf("failed to get fakesink element: %v", err)
+			}
+			if !dummyelement.PostElementMessage(sink.UnsafePointer(), "test-message, count=(int)3, endtime=(guint64)100") {
+				t.Fatal("failed to post element message")
+			}
+
+			select {
+			case m := <-ch:
+				if !tt.received {
+					t.Fatalf("unexpected element message %s", m.name)
+				}
+				if m.src != "sink" {
+					t.Errorf("unexpected source %s, expected \"sink\"", m.src)
+				}
+				if m.name != "test-message" {
+					t.Errorf("unexpected structure name %s, expected \"test-message\"", m.name)
+				}
+				expected := map[string]interface{}{
+					"count":   3,
+					"endtime": uint64(100),
+				}
+				if !reflect.DeepEqual(expected, m.fields) {
+					t.Errorf("expected fields %v, got %v", expected, m.fields)
+				}
+			case <-time.After(200 * time.Millisecond):
+				if tt.received {
+					t.Fatal("expected element message, but timed-out")
+				}
+			}
+		})
+	}
+}
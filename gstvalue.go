@@ -0,0 +1,336 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gst
+
+// #cgo pkg-config: gobject-2.0 gstreamer-1.0
+// #include "gvalue.h"
+import "C"
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Fraction is a rational number like a framerate.
+type Fraction struct {
+	Num, Denom int
+}
+
+// String returns string representation of the Fraction like "30/1".
+func (f Fraction) String() string {
+	return fmt.Sprintf("%d/%d", f.Num, f.Denom)
+}
+
+// IntRange is a range of integers from Min to Max in Step.
+type IntRange struct {
+	Min, Max, Step int
+}
+
+// DoubleRange is a range of floating point numbers.
+type DoubleRange struct {
+	Min, Max float64
+}
+
+// FractionRange is a range of fractions.
+type FractionRange struct {
+	Min, Max Fraction
+}
+
+// ValueList is an unordered list of the alternative values like "{ I420, NV12 }".
+type ValueList []interface{}
+
+// ValueArray is an ordered array of the values like "< 1, 2 >".
+type ValueArray []interface{}
+
+// goGstBoxedValue converts the GValue of GStreamer boxed types.
+// It returns false if the type is not supported.
+func goGstBoxedValue(v *C.GValue, t C.GType) (interface{}, bool) {
+	switch t {
+	case C.gstCapsType():
+		p := C.gst_value_get_caps(v)
+		if p == nil {
+			return nil, true
+		}
+		return newCapsRef(p), true
+	case C.gstStructureType():
+		p := C.gst_value_get_structure(v)
+		if p == nil {
+			return nil, true
+		}
+		return newStructure(C.gst_structure_copy(p)), true
+	case C.gstDateTimeType():
+		p := (*C.GstDateTime)(C.g_value_get_boxed(v))
+		if p == nil {
+			return nil, true
+		}
+		return goDateTime(p), true
+	case C.gDateType():
+		p := (*C.GDate)(C.g_value_get_boxed(v))
+		if p == nil || C.g_date_valid(p) == 0 {
+			return nil, true
+		}
+		return time.Date(
+			int(C.g_date_get_year(p)), time.Month(C.g_date_get_month(p)), int(C.g_date_get_day(p)),
+			0, 0, 0, 0, time.UTC,
+		), true
+	}
+	return nil, false
+}
+
+// goDateTime converts GstDateTime to time.Time.
+// Missing fields of the partial date time are filled by the minimum values.
+func goDateTime(p *C.GstDateTime) time.Time {
+	year := int(C.gst_date_time_get_year(p))
+	month, day := time.January, 1
+	if C.gst_date_time_has_month(p) != 0 {
+		month = time.Month(C.gst_date_time_get_month(p))
+	}
+	if C.gst_date_time_has_day(p) != 0 {
+		day = int(C.gst_date_time_get_day(p))
+	}
+	if C.gst_date_time_has_time(p) == 0 {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	hour := int(C.gst_date_time_get_hour(p))
+	minute := int(C.gst_date_time_get_minute(p))
+	var sec, usec int
+	if C.gst_date_time_has_second(p) != 0 {
+		sec = int(C.gst_date_time_get_second(p))
+		usec = int(C.gst_date_time_get_microsecond(p))
+	}
+	offset := int(float64(C.gst_date_time_get_time_zone_offset(p)) * 3600)
+	return time.Date(year, month, day, hour, minute, sec, usec*1000, time.FixedZone("", offset))
+}
+
+// goGstValue converts the GValue of GStreamer fundamental types.
+func goGstValue(v *C.GValue, t C.GType) (interface{}, error) {
+	switch t {
+	case C.gstFractionType():
+		return goFraction(v), nil
+	case C.gstIntRangeType():
+		return IntRange{
+			Min:  int(C.gst_value_get_int_range_min(v)),
+			Max:  int(C.gst_value_get_int_range_max(v)),
+			Step: int(C.gst_value_get_int_range_step(v)),
+		}, nil
+	case C.gstDoubleRangeType():
+		return DoubleRange{
+			Min: float64(C.gst_value_get_double_range_min(v)),
+			Max: float64(C.gst_value_get_double_range_max(v)),
+		}, nil
+	case C.gstFractionRangeType():
+		return FractionRange{
+			Min: goFraction(C.gst_value_get_fraction_range_min(v)),
+			Max: goFraction(C.gst_value_get_fraction_range_max(v)),
+		}, nil
+	case C.gstListType():
+		n := C.gst_value_list_get_size(v)
+		ret := make(ValueList, 0, int(n))
+		for i := C.guint(0); i < n; i++ {
+			val, err := goValue(C.gst_value_list_get_value(v, i))
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, val)
+		}
+		return ret, nil
+	case C.gstArrayType():
+		n := C.gst_value_array_get_size(v)
+		ret := make(ValueArray, 0, int(n))
+		for i := C.guint(0); i < n; i++ {
+			val, err := goValue(C.gst_value_array_get_value(v, i))
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, val)
+		}
+		return ret, nil
+	}
+	return nil, fmt.Errorf("Unsupported GValue type %s", typeName(t))
+}
+
+func goFraction(v *C.GValue) Fraction {
+	return Fraction{
+		Num:   int(C.gst_value_get_fraction_numerator(v)),
+		Denom: int(C.gst_value_get_fraction_denominator(v)),
+	}
+}
+
+// setGstBoxedValue stores the Go value to the GValue of GStreamer boxed types.
+// It returns false if the type is not supported.
+func setGstBoxedValue(v *C.GValue, t C.GType, val interface{}) (bool, error) {
+	switch t {
+	case C.gstCapsType():
+		switch val := val.(type) {
+		case nil:
+			C.gst_value_set_caps(v, nil)
+		case *Caps:
+			C.gst_value_set_caps(v, val.p)
+		case string:
+			caps, err := ParseCaps(val)
+			if err != nil {
+				return true, err
+			}
+			C.gst_value_set_caps(v, caps.p)
+		default:
+			return true, errValueType(val, t)
+		}
+		return true, nil
+	case C.gstStructureType():
+		switch val := val.(type) {
+		case nil:
+			C.gst_value_set_structure(v, nil)
+		case *Structure:
+			C.gst_value_set_structure(v, val.p)
+		case string:
+			s, err := ParseStructure(val)
+			if err != nil {
+				return true, err
+			}
+			C.gst_value_set_structure(v, s.p)
+		default:
+			return true, errValueType(val, t)
+		}
+		return true, nil
+	case C.gstDateTimeType():
+		tm, ok := val.(time.Time)
+		if !ok {
+			return true, errValueType(val, t)
+		}
+		_, offset := tm.Zone()
+		sec := float64(tm.Second()) + float64(tm.Nanosecond()/1000)/1e6
+		C.g_value_take_boxed(v, C.gpointer(C.gst_date_time_new(
+			C.gfloat(float64(offset)/3600), C.gint(tm.Year()), C.gint(tm.Month()), C.gint(tm.Day()),
+			C.gint(tm.Hour()), C.gint(tm.Minute()), C.gdouble(sec),
+		)))
+		return true, nil
+	case C.gDateType():
+		tm, ok := val.(time.Time)
+		if !ok {
+			return true, errValueType(val, t)
+		}
+		C.g_value_take_boxed(v, C.gpointer(C.g_date_new_dmy(
+			C.GDateDay(tm.Day()), C.GDateMonth(tm.Month()), C.GDateYear(tm.Year()),
+		)))
+		return true, nil
+	}
+	return false, nil
+}
+
+// setGstValue stores the Go value to the GValue of GStreamer fundamental types.
+func setGstValue(v *C.GValue, t C.GType, val interface{}) error {
+	switch t {
+	case C.gstFractionType():
+		f, ok := val.(Fraction)
+		if !ok {
+			return errValueType(val, t)
+		}
+		if f.Denom == 0 {
+			return fmt.Errorf("Denominator of the fraction must not be zero")
+		}
+		C.gst_value_set_fraction(v, C.gint(f.Num), C.gint(f.Denom))
+	case C.gstIntRangeType():
+		r, ok := val.(IntRange)
+		if !ok {
+			return errValueType(val, t)
+		}
+		if r.Step == 0 {
+			r.Step = 1
+		}
+		if r.Step < 0 || r.Min < math.MinInt32 || math.MaxInt32 < r.Max || r.Max <= r.Min ||
+			(r.Max-r.Min)%r.Step != 0 || r.Min%r.Step != 0 {
+			return fmt.Errorf("Invalid integer range %v", r)
+		}
+		C.gst_value_set_int_range_step(v, C.gint(r.Min), C.gint(r.Max), C.gint(r.Step))
+	case C.gstDoubleRangeType():
+		r, ok := val.(DoubleRange)
+		if !ok {
+			return errValueType(val, t)
+		}
+		if r.Max <= r.Min {
+			return fmt.Errorf("Invalid double range %v", r)
+		}
+		C.gst_value_set_double_range(v, C.gdouble(r.Min), C.gdouble(r.Max))
+	case C.gstFractionRangeType():
+		r, ok := val.(FractionRange)
+		if !ok {
+			return errValueType(val, t)
+		}
+		if r.Min.Denom == 0 || r.Max.Denom == 0 {
+			return fmt.Errorf("Denominator of the fraction must not be zero")
+		}
+		C.gst_value_set_fraction_range_full(v,
+			C.gint(r.Min.Num), C.gint(r.Min.Denom), C.gint(r.Max.Num), C.gint(r.Max.Denom))
+	case C.gstListType():
+		l, ok := val.(ValueList)
+		if !ok {
+			return errValueType(val, t)
+		}
+		for _, e := range l {
+			if err := appendGValue(e, func(ev *C.GValue) { C.gst_value_list_append_value(v, ev) }); err != nil {
+				return err
+			}
+		}
+	case C.gstArrayType():
+		a, ok := val.(ValueArray)
+		if !ok {
+			return errValueType(val, t)
+		}
+		for _, e := range a {
+			if err := appendGValue(e, func(ev *C.GValue) { C.gst_value_array_append_value(v, ev) }); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("Unsupported GValue type %s", typeName(t))
+	}
+	return nil
+}
+
+func appendGValue(val interface{}, appendFn func(*C.GValue)) error {
+	ev := C.newGValue()
+	defer C.freeGValue(ev)
+	if err := initGValue(ev, val); err != nil {
+		return err
+	}
+	appendFn(ev)
+	return nil
+}
+
+// gstValueTypeOf returns the GType to store the Go value of GStreamer types.
+func gstValueTypeOf(val interface{}) (C.GType, bool) {
+	switch val.(type) {
+	case *Caps:
+		return C.gstCapsType(), true
+	case *Structure:
+		return C.gstStructureType(), true
+	case Fraction:
+		return C.gstFractionType(), true
+	case IntRange:
+		return C.gstIntRangeType(), true
+	case DoubleRange:
+		return C.gstDoubleRangeType(), true
+	case FractionRange:
+		return C.gstFractionRangeType(), true
+	case ValueList:
+		return C.gstListType(), true
+	case ValueArray:
+		return C.gstArrayType(), true
+	case time.Time:
+		return C.gstDateTimeType(), true
+	}
+	return 0, false
+}
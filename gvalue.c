@@ -0,0 +1,107 @@
+/* Copyright 2026 SEQSENSE, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+#include <stdlib.h>
+#include <glib-object.h>
+#include <gst/gst.h>
+
+#include "gvalue.h"
+
+GValue* newGValue()
+{
+  GValue* value = malloc(sizeof(GValue));
+  GValue init = G_VALUE_INIT;
+  *value = init;
+  return value;
+}
+void freeGValue(GValue* value)
+{
+  if (G_IS_VALUE(value))
+    g_value_unset(value);
+  free(value);
+}
+GType getValueType(GValue* value)
+{
+  return G_VALUE_TYPE(value);
+}
+GValue* newGValues(guint n)
+{
+  return calloc(n, sizeof(GValue));
+}
+void freeGValues(GValue* values, guint n)
+{
+  for (guint i = 0; i < n; ++i)
+  {
+    if (G_IS_VALUE(&values[i]))
+      g_value_unset(&values[i]);
+  }
+  free(values);
+}
+GValue* gValueAt(GValue* values, guint i)
+{
+  return &values[i];
+}
+GType getObjectType(void* object)
+{
+  return G_OBJECT_TYPE(object);
+}
+gboolean isElement(void* object)
+{
+  return GST_IS_ELEMENT(object);
+}
+gboolean isPad(void* object)
+{
+  return GST_IS_PAD(object);
+}
+GType gstCapsType()
+{
+  return GST_TYPE_CAPS;
+}
+GType gstStructureType()
+{
+  return GST_TYPE_STRUCTURE;
+}
+GType gstFractionType()
+{
+  return GST_TYPE_FRACTION;
+}
+GType gstIntRangeType()
+{
+  return GST_TYPE_INT_RANGE;
+}
+GType gstDoubleRangeType()
+{
+  return GST_TYPE_DOUBLE_RANGE;
+}
+GType gstFractionRangeType()
+{
+  return GST_TYPE_FRACTION_RANGE;
+}
+GType gstListType()
+{
+  return GST_TYPE_LIST;
+}
+GType gstArrayType()
+{
+  return GST_TYPE_ARRAY;
+}
+GType gstDateTimeType()
+{
+  return GST_TYPE_DATE_TIME;
+}
+GType gDateType()
+{
+  return G_TYPE_DATE;
+}
@@ -0,0 +1,43 @@
+/* Copyright 2026 SEQSENSE, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+#ifndef GVALUE_H
+#define GVALUE_H
+
+#include <stdlib.h>
+#include <glib-object.h>
+#include <gst/gst.h>
+
+GValue* newGValue();
+void freeGValue(GValue* value);
+GType getValueType(GValue* value);
+GValue* newGValues(guint n);
+void freeGValues(GValue* values, guint n);
+GValue* gValueAt(GValue* values, guint i);
+GType getObjectType(void* object);
+gboolean isElement(void* object);
+gboolean isPad(void* object);
+GType gstCapsType();
+GType gstStructureType();
+GType gstFractionType();
+GType gstIntRangeType();
+GType gstDoubleRangeType();
+GType gstFractionRangeType();
+GType gstListType();
+GType gstArrayType();
+GType gstDateTimeType();
+GType gDateType();
+
+#endif  // GVALUE_H
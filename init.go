@@ -0,0 +1,227 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gst
+
+// #cgo pkg-config: gobject-2.0 gstreamer-1.0
+// #include <stdlib.h>
+// #include <gst/gst.h>
+// static GMainLoop* mainloop = NULL;
+// static GThread* mainloopThread = NULL;
+// static gpointer runMainLoop(gpointer loop)
+// {
+//   g_main_loop_run(loop);
+//   return NULL;
+// }
+// char* initGst(int argc, char** argv)
+// {
+//   GError* err = NULL;
+//   if (!gst_init_check(&argc, &argv, &err))
+//   {
+//     char* msg = g_strdup(err != NULL ? err->message : "unknown error");
+//     if (err != NULL)
+//       g_error_free(err);
+//     return msg;
+//   }
+//   return NULL;
+// }
+// void startMainLoop()
+// {
+//   mainloop = g_main_loop_new(NULL, FALSE);
+//   mainloopThread = g_thread_new("mainloop", runMainLoop, mainloop);
+// }
+// void stopMainLoop()
+// {
+//   if (mainloop == NULL)
+//     return;
+//   g_main_loop_quit(mainloop);
+//   g_thread_join(mainloopThread);
+//   g_main_loop_unref(mainloop);
+//   mainloop = NULL;
+//   mainloopThread = NULL;
+// }
+// char** newArgv(int n)
+// {
+//   return calloc(n + 1, sizeof(char*));
+// }
+// void setArgv(char** argv, int i, char* arg)
+// {
+//   argv[i] = arg;
+// }
+import "C"
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"unsafe"
+)
+
+// InitOptions is the options of GStreamer initialization.
+type InitOptions struct {
+	// Args are additional GStreamer command line arguments like "--gst-debug-level=3".
+	Args []string
+	// Debug is a list of the debug categories and the levels like "GST_ELEMENT_*:4,rtsp*:6".
+	Debug string
+	// DebugNoColor disables colored debug output.
+	DebugNoColor bool
+	// PluginPaths are the directories to find plugins in addition to the default paths.
+	PluginPaths []string
+	// PluginLoad is a list of the plugin files preloaded on the initialization.
+	PluginLoad []string
+	// RegistryPath is a path of the registry cache file.
+	// It overrides GST_REGISTRY environment variable.
+	// Since GStreamer reads the path only from the environment variable,
+	// GST_REGISTRY of the process is temporarily changed during Init and
+	// restored after the initialization.
+	RegistryPath string
+	// DisableRegistryUpdate disables updating the registry cache by scanning plugins.
+	DisableRegistryUpdate bool
+	// DisableRegistryFork disables forking a child process to scan plugins.
+	DisableRegistryFork bool
+}
+
+func (o *InitOptions) args() []string {
+	if o == nil {
+		return nil
+	}
+	var args []string
+	if o.Debug != "" {
+		args = append(args, "--gst-debug="+o.Debug)
+	}
+	if o.DebugNoColor {
+		args = append(args, "--gst-debug-no-color")
+	}
+	if len(o.PluginPaths) > 0 {
+		args = append(args, "--gst-plugin-path="+strings.Join(o.PluginPaths, string(os.PathListSeparator)))
+	}
+	if len(o.PluginLoad) > 0 {
+		args = append(args, "--gst-plugin-load="+strings.Join(o.PluginLoad, ","))
+	}
+	if o.DisableRegistryUpdate {
+		args = append(args, "--gst-disable-registry-update")
+	}
+	if o.DisableRegistryFork {
+		args = append(args, "--gst-disable-registry-fork")
+	}
+	return append(args, o.Args...)
+}
+
+var (
+	initMutex   sync.Mutex
+	initialized bool
+	deinited    bool
+
+	// ErrDeinitialized is returned if GStreamer is used after Deinit.
+	ErrDeinitialized = errors.New("GStreamer is already deinitialized")
+)
+
+// Init initializes GStreamer and starts the main loop thread dispatching
+// the bus messages.
+// It must be called before using other functions of the package.
+// Init is safe to be called multiple times from multiple goroutines and
+// the options are ignored once GStreamer is initialized.
+// GStreamer can not be initialized again after Deinit.
+func Init(opts *InitOptions) error {
+	initMutex.Lock()
+	defer initMutex.Unlock()
+
+	if deinited {
+		return ErrDeinitialized
+	}
+	if initialized {
+		return nil
+	}
+
+	if opts != nil && opts.RegistryPath != "" {
+		restore, err := setenvTemporarily("GST_REGISTRY", opts.RegistryPath)
+		if err != nil {
+			return err
+		}
+		defer restore()
+	}
+
+	args := append([]string{os.Args[0]}, opts.args()...)
+	// gst_init_check removes the parsed arguments from argv.
+	// Keep the strings to free them regardless of the modification.
+	argv := C.newArgv(C.int(len(args)))
+	defer C.free(unsafe.Pointer(argv))
+	for i, a := range args {
+		cArg := C.CString(a)
+		defer C.free(unsafe.Pointer(cArg))
+		C.setArgv(argv, C.int(i), cArg)
+	}
+	if msg := C.initGst(C.int(len(args)), argv); msg != nil {
+		defer C.g_free(C.gpointer(msg))
+		return fmt.Errorf("Failed to initialize GStreamer: %s", C.GoString(msg))
+	}
+	C.startMainLoop()
+	initialized = true
+	return nil
+}
+
+// setenvTemporarily sets the environment variable and returns a function
+// to restore the original value.
+func setenvTemporarily(key, val string) (func(), error) {
+	orig, ok := os.LookupEnv(key)
+	if err := os.Setenv(key, val); err != nil {
+		return nil, err
+	}
+	return func() {
+		if ok {
+			os.Setenv(key, orig)
+		} else {
+			os.Unsetenv(key)
+		}
+	}, nil
+}
+
+// IsInitialized returns true if GStreamer is initialized and not deinitialized.
+func IsInitialized() bool {
+	initMutex.Lock()
+	defer initMutex.Unlock()
+	return initialized && !deinited
+}
+
+// Deinit stops the main loop thread and releases all resources of GStreamer.
+// It is mostly useful to check memory leaks.
+// All GStreamer objects must be released before calling Deinit.
+func Deinit() {
+	initMutex.Lock()
+	defer initMutex.Unlock()
+
+	if !initialized || deinited {
+		return
+	}
+	C.stopMainLoop()
+	C.gst_deinit()
+	deinited = true
+}
+
+// Version returns the version of the GStreamer runtime library.
+// nano is 0 for the releases, 1 for the git versions and 2 or later for the pre-releases.
+func Version() (major, minor, micro, nano uint) {
+	var cMajor, cMinor, cMicro, cNano C.guint
+	C.gst_version(&cMajor, &cMinor, &cMicro, &cNano)
+	return uint(cMajor), uint(cMinor), uint(cMicro), uint(cNano)
+}
+
+// VersionString returns the version of the GStreamer runtime library like "GStreamer 1.20.3".
+func VersionString() string {
+	str := C.gst_version_string()
+	defer C.g_free(C.gpointer(str))
+	return C.GoString(str)
+}
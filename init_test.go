@@ -0,0 +1,157 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gst
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// initTestEnv is set to run the initialization test in a subprocess
+// since GStreamer is initialized only once in the process.
+const initTestEnv = "SQ_GST_GO_TEST_INIT"
+
+func TestMain(m *testing.M) {
+	if dir := os.Getenv(initTestEnv); dir != "" {
+		os.Exit(runInitTest(dir))
+	}
+	if err := Init(&InitOptions{DebugNoColor: true}); err != nil {
+		panic(err)
+	}
+	code := m.Run()
+	Deinit()
+	os.Exit(code)
+}
+
+func TestInit(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := Init(nil); err != nil {
+				t.Errorf("Init must be idempotent: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if !IsInitialized() {
+		t.Error("GStreamer must be initialized")
+	}
+}
+
+func TestInit_firstCall(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sq-gst-go-test")
+	if err != nil {
+		t.Fatalf("Failed to create temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	cmd := exec.Command(os.Args[0], "-test.run=^$")
+	cmd.Env = append(os.Environ(), initTestEnv+"="+dir)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Errorf("Initialization test failed: %v\n%s", err, out)
+	}
+}
+
+// runInitTest runs concurrent Init from uninitialized state.
+// It is called in the subprocess spawned by TestInit_firstCall.
+func runInitTest(dir string) int {
+	if IsInitialized() {
+		fmt.Println("GStreamer must not be initialized before Init")
+		return 1
+	}
+	os.Unsetenv("GST_REGISTRY")
+	registry := filepath.Join(dir, "registry.bin")
+
+	var wg sync.WaitGroup
+	var nErr int32
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := Init(&InitOptions{RegistryPath: registry}); err != nil {
+				fmt.Printf("Init failed: %v\n", err)
+				atomic.AddInt32(&nErr, 1)
+			}
+		}()
+	}
+	wg.Wait()
+	if nErr > 0 {
+		return 1
+	}
+	if !IsInitialized() {
+		fmt.Println("GStreamer must be initialized")
+		return 1
+	}
+	if _, err := os.Stat(registry); err != nil {
+		fmt.Printf("Registry must be stored to RegistryPath: %v\n", err)
+		return 1
+	}
+	if v, ok := os.LookupEnv("GST_REGISTRY"); ok {
+		fmt.Printf("GST_REGISTRY must be restored, but got %s\n", v)
+		return 1
+	}
+
+	Deinit()
+	if IsInitialized() {
+		fmt.Println("GStreamer must not be initialized after Deinit")
+		return 1
+	}
+	if err := Init(nil); err != ErrDeinitialized {
+		fmt.Printf("Expected ErrDeinitialized, got %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+func TestInitOptions(t *testing.T) {
+	opts := &InitOptions{
+		Args:                  []string{"--gst-debug-level=2"},
+		Debug:                 "*:3",
+		PluginPaths:           []string{"/a", "/b"},
+		DisableRegistryUpdate: true,
+	}
+	expected := []string{
+		"--gst-debug=*:3",
+		"--gst-plugin-path=/a" + string(os.PathListSeparator) + "/b",
+		"--gst-disable-registry-update",
+		"--gst-debug-level=2",
+	}
+	if args := opts.args(); strings.Join(args, " ") != strings.Join(expected, " ") {
+		t.Errorf("Expected args %v, got %v", expected, args)
+	}
+	var nilOpts *InitOptions
+	if args := nilOpts.args(); len(args) != 0 {
+		t.Errorf("Nil options must not have args, but got %v", args)
+	}
+}
+
+func TestVersion(t *testing.T) {
+	major, minor, _, _ := Version()
+	if major != 1 {
+		t.Errorf("Expected GStreamer 1.x, got %d.%d", major, minor)
+	}
+	if s := VersionString(); !strings.HasPrefix(s, "GStreamer 1.") {
+		t.Errorf("Unexpected version string %s", s)
+	}
+}
@@ -19,25 +19,125 @@ import (
 )
 
 // #cgo pkg-config: gobject-2.0 gstreamer-1.0 gstreamer-base-1.0
+// #include <stdlib.h>
 // #include "gst/gst.h"
-// void init()
-// {
-//   int argc = 1;
-//   char* exec_name = "rtsp_receiver";
-//   char** argv = &exec_name;
-//   gst_init(&argc, &argv);
-// }
 // GstElement* newElement()
 // {
 //   return gst_element_factory_make("fakesink", "fakesink");
 // }
+// GstElement* newElementWithFactory(const char* factory)
+// {
+//   return gst_element_factory_make(factory, NULL);
+// }
+// void postReport(void* element, gboolean warning, const char* msg, const char* debug)
+// {
+//   GError* err = g_error_new_literal(GST_CORE_ERROR, GST_CORE_ERROR_FAILED, msg);
+//   GstMessage* m;
+//   if (warning)
+//     m = gst_message_new_warning(GST_OBJECT(element), err, debug);
+//   else
+//     m = gst_message_new_info(GST_OBJECT(element), err, debug);
+//   g_error_free(err);
+//   gst_element_post_message(element, m);
+// }
+// void postError(void* element, const char* msg, const char* debug)
+// {
+//   GError* err = g_error_new_literal(GST_RESOURCE_ERROR, GST_RESOURCE_ERROR_NOT_FOUND, msg);
+//   GstMessage* m = gst_message_new_error(GST_OBJECT(element), err, debug);
+//   g_error_free(err);
+//   gst_element_post_message(element, m);
+// }
+// gboolean postTag(void* element, const char* tags)
+// {
+//   GstTagList* t = gst_tag_list_new_from_string(tags);
+//   if (t == NULL)
+//     return FALSE;
+//   return gst_element_post_message(element, gst_message_new_tag(GST_OBJECT(element), t));
+// }
+// gboolean postElementMessage(void* element, const char* structure)
+// {
+//   GstStructure* s = gst_structure_from_string(structure, NULL);
+//   if (s == NULL)
+//     return FALSE;
+//   return gst_element_post_message(element, gst_message_new_element(GST_OBJECT(element), s));
+// }
+// void postBuffering(void* element, int percent, GstBufferingMode mode, gint64 left)
+// {
+//   GstMessage* m = gst_message_new_buffering(GST_OBJECT(element), percent);
+//   gst_message_set_buffering_stats(m, mode, 0, 0, left);
+//   gst_element_post_message(element, m);
+// }
 import "C"
 
-func init() {
-	C.init()
-}
-
 // New returns dummy GstElement pointer. This is for internal testing.
+// gst.Init must be called beforehand.
 func New() unsafe.Pointer {
 	return unsafe.Pointer(C.newElement())
 }
+
+// NewWithFactory returns GstElement pointer created by the given factory.
+// This is for internal testing.
+func NewWithFactory(factory string) unsafe.Pointer {
+	cFactory := C.CString(factory)
+	defer C.free(unsafe.Pointer(cFactory))
+	return unsafe.Pointer(C.newElementWithFactory(cFactory))
+}
+
+// PostError posts an error message of GST_RESOURCE_ERROR_NOT_FOUND from the element.
+// This is for internal testing.
+func PostError(element unsafe.Pointer, msg, debug string) {
+	cMsg := C.CString(msg)
+	defer C.free(unsafe.Pointer(cMsg))
+	cDebug := C.CString(debug)
+	defer C.free(unsafe.Pointer(cDebug))
+	C.postError(element, cMsg, cDebug)
+}
+
+// PostWarning posts a warning message of GST_CORE_ERROR_FAILED from the element.
+// This is for internal testing.
+func PostWarning(element unsafe.Pointer, msg, debug string) {
+	postReport(element, true, msg, debug)
+}
+
+// PostInfo posts an info message of GST_CORE_ERROR_FAILED from the element.
+// This is for internal testing.
+func PostInfo(element unsafe.Pointer, msg, debug string) {
+	postReport(element, false, msg, debug)
+}
+
+// PostBuffering posts a buffering message of stream mode from the element.
+// left is the estimated time remaining in milliseconds.
+// This is for internal testing.
+func PostBuffering(element unsafe.Pointer, percent int, left int64) {
+	C.postBuffering(element, C.int(percent), C.GST_BUFFERING_STREAM, C.gint64(left))
+}
+
+// PostElementMessage posts an element message having the structure given
+// in the string representation from the element.
+// This is for internal testing.
+func PostElementMessage(element unsafe.Pointer, structure string) bool {
+	cStructure := C.CString(structure)
+	defer C.free(unsafe.Pointer(cStructure))
+	return C.postElementMessage(element, cStructure) != 0
+}
+
+// PostTag posts a tag message having the tag list given
+// in the string representation from the element.
+// This is for internal testing.
+func PostTag(element unsafe.Pointer, tags string) bool {
+	cTags := C.CString(tags)
+	defer C.free(unsafe.Pointer(cTags))
+	return C.postTag(element, cTags) != 0
+}
+
+func postReport(element unsafe.Pointer, warning bool, msg, debug string) {
+	cMsg := C.CString(msg)
+	defer C.free(unsafe.Pointer(cMsg))
+	cDebug := C.CString(debug)
+	defer C.free(unsafe.Pointer(cDebug))
+	var w C.gboolean
+	if warning {
+		w = C.TRUE
+	}
+	C.postReport(element, w, cMsg, cDebug)
+}
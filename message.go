@@ -0,0 +1,143 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gst
+
+// #cgo pkg-config: gobject-2.0 gstreamer-1.0
+// #include <gst/gst.h>
+import "C"
+
+import (
+	"fmt"
+)
+
+// MessageType is a type of GstMessage.
+// The values except MessageExtended are bit flags and can be combined as a mask.
+type MessageType uint
+
+const (
+	// MessageUnknown is an undefined message type.
+	MessageUnknown MessageType = C.GST_MESSAGE_UNKNOWN
+	// MessageEOS is posted when the pipeline reached the end of the stream.
+	MessageEOS MessageType = C.GST_MESSAGE_EOS
+	// MessageError is posted when an element raised an error.
+	MessageError MessageType = C.GST_MESSAGE_ERROR
+	// MessageWarning is posted when an element raised a warning.
+	MessageWarning MessageType = C.GST_MESSAGE_WARNING
+	// MessageInfo is posted when an element reported an information.
+	MessageInfo MessageType = C.GST_MESSAGE_INFO
+	// MessageTag is posted when an element found tags in the stream.
+	MessageTag MessageType = C.GST_MESSAGE_TAG
+	// MessageBuffering is posted by the elements buffering the stream.
+	MessageBuffering MessageType = C.GST_MESSAGE_BUFFERING
+	// MessageStateChanged is posted when the state of an element is changed.
+	MessageStateChanged MessageType = C.GST_MESSAGE_STATE_CHANGED
+	// MessageStateDirty is deprecated and not used.
+	MessageStateDirty MessageType = C.GST_MESSAGE_STATE_DIRTY
+	// MessageStepDone is posted when the step operation is completed.
+	MessageStepDone MessageType = C.GST_MESSAGE_STEP_DONE
+	// MessageClockProvide is posted when an element can provide a clock.
+	MessageClockProvide MessageType = C.GST_MESSAGE_CLOCK_PROVIDE
+	// MessageClockLost is posted when the clock provided by an element became unusable.
+	MessageClockLost MessageType = C.GST_MESSAGE_CLOCK_LOST
+	// MessageNewClock is posted when a new clock is selected by the pipeline.
+	MessageNewClock MessageType = C.GST_MESSAGE_NEW_CLOCK
+	// MessageStructureChange is posted when the pipeline structure is changed by linking or unlinking pads.
+	MessageStructureChange MessageType = C.GST_MESSAGE_STRUCTURE_CHANGE
+	// MessageStreamStatus is posted when the status of a streaming thread is changed.
+	MessageStreamStatus MessageType = C.GST_MESSAGE_STREAM_STATUS
+	// MessageApplication is an application specific message.
+	MessageApplication MessageType = C.GST_MESSAGE_APPLICATION
+	// MessageElement is an element specific message.
+	MessageElement MessageType = C.GST_MESSAGE_ELEMENT
+	// MessageSegmentStart is posted when the segment playback is started.
+	MessageSegmentStart MessageType = C.GST_MESSAGE_SEGMENT_START
+	// MessageSegmentDone is posted when the segment seek reached the stop position.
+	MessageSegmentDone MessageType = C.GST_MESSAGE_SEGMENT_DONE
+	// MessageDurationChanged is posted when the duration of the stream is changed.
+	MessageDurationChanged MessageType = C.GST_MESSAGE_DURATION_CHANGED
+	// MessageLatency is posted when the latency of the pipeline should be recalculated.
+	MessageLatency MessageType = C.GST_MESSAGE_LATENCY
+	// MessageAsyncStart is posted when an element started the asynchronous state change.
+	MessageAsyncStart MessageType = C.GST_MESSAGE_ASYNC_START
+	// MessageAsyncDone is posted when the asynchronous state change is completed.
+	MessageAsyncDone MessageType = C.GST_MESSAGE_ASYNC_DONE
+	// MessageRequestState is posted when an element requests the state change of the pipeline.
+	MessageRequestState MessageType = C.GST_MESSAGE_REQUEST_STATE
+	// MessageStepStart is posted when the step operation is started.
+	MessageStepStart MessageType = C.GST_MESSAGE_STEP_START
+	// MessageQOS is posted when a buffer is dropped or processed late.
+	MessageQOS MessageType = C.GST_MESSAGE_QOS
+	// MessageProgress is posted to report the progress of an asynchronous operation.
+	MessageProgress MessageType = C.GST_MESSAGE_PROGRESS
+	// MessageTOC is posted when an element found a table of contents.
+	MessageTOC MessageType = C.GST_MESSAGE_TOC
+	// MessageResetTime is posted to reset the running time of the pipeline.
+	MessageResetTime MessageType = C.GST_MESSAGE_RESET_TIME
+	// MessageStreamStart is posted when the stream is started.
+	MessageStreamStart MessageType = C.GST_MESSAGE_STREAM_START
+	// MessageNeedContext is posted when an element needs a context.
+	MessageNeedContext MessageType = C.GST_MESSAGE_NEED_CONTEXT
+	// MessageHaveContext is posted when an element created a context.
+	MessageHaveContext MessageType = C.GST_MESSAGE_HAVE_CONTEXT
+	// MessageExtended is set on the types which are not bit flags
+	// like GST_MESSAGE_DEVICE_ADDED.
+	MessageExtended MessageType = 1 << 31
+	// MessageAny matches all message types.
+	MessageAny MessageType = 0xffffffff
+)
+
+// String returns the name of the MessageType.
+func (t MessageType) String() string {
+	return C.GoString(C.gst_message_type_get_name(C.GstMessageType(t)))
+}
+
+// Matches returns true if the type is included in the mask.
+// Extended types are matched only if the mask has MessageExtended.
+func (t MessageType) Matches(mask MessageType) bool {
+	if t&MessageExtended != 0 {
+		return mask&MessageExtended != 0
+	}
+	return t&mask != 0
+}
+
+// BufferingMode is a buffering method of the element.
+type BufferingMode int
+
+const (
+	// BufferingStream buffers a small amount of data in memory.
+	BufferingStream BufferingMode = C.GST_BUFFERING_STREAM
+	// BufferingDownload downloads the whole stream to a file.
+	BufferingDownload BufferingMode = C.GST_BUFFERING_DOWNLOAD
+	// BufferingTimeshift stores a ring buffer of the stream to a file.
+	BufferingTimeshift BufferingMode = C.GST_BUFFERING_TIMESHIFT
+	// BufferingLive buffers the live stream.
+	BufferingLive BufferingMode = C.GST_BUFFERING_LIVE
+)
+
+// String returns string representation of the BufferingMode.
+func (m BufferingMode) String() string {
+	switch m {
+	case BufferingStream:
+		return "BufferingStream"
+	case BufferingDownload:
+		return "BufferingDownload"
+	case BufferingTimeshift:
+		return "BufferingTimeshift"
+	case BufferingLive:
+		return "BufferingLive"
+	default:
+		return fmt.Sprintf("Unknown BufferingMode (%d)", int(m))
+	}
+}
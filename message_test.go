@@ -0,0 +1,47 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gst
+
+import (
+	"testing"
+)
+
+func TestMessageType(t *testing.T) {
+	if s := MessageStateChanged.String(); s != "state-changed" {
+		t.Errorf("expected \"state-changed\", got %s", s)
+	}
+
+	testCases := map[string]struct {
+		typ     MessageType
+		mask    MessageType
+		matches bool
+	}{
+		"Single":          {MessageEOS, MessageEOS, true},
+		"Combined":        {MessageError, MessageEOS | MessageError, true},
+		"NotMatched":      {MessageWarning, MessageEOS | MessageError, false},
+		"Any":             {MessageTag, MessageAny, true},
+		"ExtendedAny":     {MessageExtended + 1, MessageAny, true},
+		"ExtendedMasked":  {MessageExtended + 1, MessageEOS, false},
+		"ExtendedEnabled": {MessageExtended + 1, MessageExtended, true},
+	}
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			if m := tt.typ.Matches(tt.mask); m != tt.matches {
+				t.Errorf("expected %v, got %v", tt.matches, m)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package netclock
+
+// #cgo pkg-config: gobject-2.0 gstreamer-1.0 gstreamer-net-1.0
+// #include <stdlib.h>
+// #include <gst/gst.h>
+// #include <gst/net/net.h>
+// static gpointer sinkFloating(gpointer object)
+// {
+//   if (object != NULL && g_object_is_floating(object))
+//     gst_object_ref_sink(object);
+//   return object;
+// }
+// GstNetTimeProvider* newTimeProvider(void* clock, const char* address, int port)
+// {
+//   return sinkFloating(gst_net_time_provider_new(clock, address, port));
+// }
+// int getTimeProviderPort(GstNetTimeProvider* provider)
+// {
+//   int port = 0;
+//   g_object_get(provider, "port", &port, NULL);
+//   return port;
+// }
+// void setTimeProviderActive(GstNetTimeProvider* provider, gboolean active)
+// {
+//   g_object_set(provider, "active", active, NULL);
+// }
+// GstClock* newNetClientClock(const char* name, const char* address, int port, GstClockTime base_time)
+// {
+//   return sinkFloating(gst_net_client_clock_new(name, address, port, base_time));
+// }
+import "C"
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"time"
+	"unsafe"
+
+	gst "github.com/seqsense/sq-gst-go"
+)
+
+// TimeProvider is a wrapper of GstNetTimeProvider which exposes the clock
+// to the network clients.
+type TimeProvider struct {
+	p    *C.GstNetTimeProvider
+	once sync.Once
+}
+
+// NewTimeProvider starts providing the time of the clock on the address and the port.
+// If address is empty, all interfaces are used.
+// If port is zero, a random port is assigned.
+func NewTimeProvider(clock *gst.Clock, address string, port int) (*TimeProvider, error) {
+	var cAddress *C.char
+	if address != "" {
+		cAddress = C.CString(address)
+		defer C.free(unsafe.Pointer(cAddress))
+	}
+	p := C.newTimeProvider(clock.UnsafePointer(), cAddress, C.int(port))
+	if p == nil {
+		return nil, fmt.Errorf("Failed to create network time provider on %s:%d", address, port)
+	}
+	t := &TimeProvider{p: p}
+	runtime.SetFinalizer(t, (*TimeProvider).Close)
+	return t, nil
+}
+
+// Port returns the port number the provider is listening.
+func (t *TimeProvider) Port() int {
+	return int(C.getTimeProviderPort(t.p))
+}
+
+// SetActive pauses or resumes answering the clients.
+func (t *TimeProvider) SetActive(active bool) {
+	var a C.gboolean
+	if active {
+		a = C.TRUE
+	}
+	C.setTimeProviderActive(t.p, a)
+}
+
+// Close stops the provider and releases the resources.
+// It is safe to call Close multiple times.
+func (t *TimeProvider) Close() {
+	t.once.Do(func() {
+		runtime.SetFinalizer(t, nil)
+		C.gst_object_unref(C.gpointer(t.p))
+	})
+}
+
+// NewClientClock creates a new clock synchronized to the time provider
+// on the remote address and the port.
+// baseTime is the initial time of the clock used until the first synchronization.
+// Use Clock.WaitForSync to wait for the synchronization.
+func NewClientClock(name, address string, port int, baseTime time.Duration) (*gst.Clock, error) {
+	var cName *C.char
+	if name != "" {
+		cName = C.CString(name)
+		defer C.free(unsafe.Pointer(cName))
+	}
+	cAddress := C.CString(address)
+	defer C.free(unsafe.Pointer(cAddress))
+	c := C.newNetClientClock(cName, cAddress, C.int(port), C.GstClockTime(baseTime))
+	if c == nil {
+		return nil, fmt.Errorf("Failed to create network client clock for %s:%d", address, port)
+	}
+	return gst.NewClock(unsafe.Pointer(c)), nil
+}
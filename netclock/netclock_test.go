@@ -0,0 +1,71 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package netclock
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	gst "github.com/seqsense/sq-gst-go"
+)
+
+func TestMain(m *testing.M) {
+	if err := gst.Init(nil); err != nil {
+		panic(err)
+	}
+	os.Exit(m.Run())
+}
+
+func TestLoopback(t *testing.T) {
+	master, err := gst.NewSystemClock(gst.ClockTypeMonotonic)
+	if err != nil {
+		t.Fatalf("Failed to create system clock: %v", err)
+	}
+	p, err := NewTimeProvider(master, "127.0.0.1", 0)
+	if err != nil {
+		t.Fatalf("Failed to create time provider: %v", err)
+	}
+	defer p.Close()
+	if p.Port() == 0 {
+		t.Fatal("Port must be assigned")
+	}
+
+	c, err := NewClientClock("client", "127.0.0.1", p.Port(), 0)
+	if err != nil {
+		t.Fatalf("Failed to create client clock: %v", err)
+	}
+	if !c.WaitForSync(5 * time.Second) {
+		t.Fatal("Client clock must be synchronized")
+	}
+	if !c.IsSynced() {
+		t.Error("Client clock must be synced after WaitForSync")
+	}
+
+	diff := c.Time() - master.Time()
+	if diff < -100*time.Millisecond || 100*time.Millisecond < diff {
+		t.Errorf("Client clock must be close to the master clock, but differs by %v", diff)
+	}
+}
+
+func TestNewTimeProvider_error(t *testing.T) {
+	master, err := gst.NewSystemClock(gst.ClockTypeMonotonic)
+	if err != nil {
+		t.Fatalf("Failed to create system clock: %v", err)
+	}
+	if _, err := NewTimeProvider(master, "invalid address", 0); err == nil {
+		t.Error("Creating time provider with invalid address must fail")
+	}
+}
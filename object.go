@@ -0,0 +1,128 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gst
+
+// #cgo pkg-config: gobject-2.0 gstreamer-1.0
+// #include <stdlib.h>
+// #include <glib-object.h>
+// #include <gst/gst.h>
+// #include "gvalue.h"
+// GValue* getProperty(void* object, const char* name)
+// {
+//   g_object_ref(object);
+//   GParamSpec* pspec = g_object_class_find_property(G_OBJECT_GET_CLASS(object), name);
+//   if (pspec == NULL || !(pspec->flags & G_PARAM_READABLE))
+//   {
+//     g_object_unref(object);
+//     return NULL;
+//   }
+//   GValue* value = newGValue();
+//   g_value_init(value, G_PARAM_SPEC_VALUE_TYPE(pspec));
+//   g_object_get_property(object, name, value);
+//   g_object_unref(object);
+//   return value;
+// }
+// GValue* newPropertyValue(void* object, const char* name)
+// {
+//   GParamSpec* pspec = g_object_class_find_property(G_OBJECT_GET_CLASS(object), name);
+//   if (pspec == NULL || !(pspec->flags & G_PARAM_WRITABLE))
+//     return NULL;
+//   GValue* value = newGValue();
+//   g_value_init(value, G_PARAM_SPEC_VALUE_TYPE(pspec));
+//   return value;
+// }
+// void setProperty(void* object, const char* name, GValue* value)
+// {
+//   g_object_set_property(object, name, value);
+// }
+import "C"
+
+import (
+	"fmt"
+	"runtime"
+	"unsafe"
+)
+
+// Object is a wrapper of GObject which is not a GstElement.
+type Object struct {
+	p unsafe.Pointer
+}
+
+// NewObject creates a new GObject wrapper from given raw pointer.
+// The wrapper takes the ownership of the reference.
+func NewObject(p unsafe.Pointer) *Object {
+	o := &Object{p: p}
+	runtime.SetFinalizer(o, finalizeObject)
+	return o
+}
+
+func newObjectRef(p unsafe.Pointer) *Object {
+	C.g_object_ref(C.gpointer(p))
+	return NewObject(p)
+}
+
+func finalizeObject(o *Object) {
+	C.g_object_unref(C.gpointer(o.p))
+}
+
+// UnsafePointer returns the raw pointer of the object.
+func (o *Object) UnsafePointer() unsafe.Pointer {
+	return o.p
+}
+
+// TypeName returns the GType name of the object.
+func (o *Object) TypeName() string {
+	return typeName(C.getObjectType(o.p))
+}
+
+// GetProperty returns property of the object.
+// See Element.GetProperty for the type mapping.
+func (o *Object) GetProperty(name string) (interface{}, error) {
+	return getObjectProperty(o.p, name)
+}
+
+// SetProperty sets property of the object.
+// See Element.SetProperty for the type mapping.
+func (o *Object) SetProperty(name string, val interface{}) error {
+	return setObjectProperty(o.p, name, val)
+}
+
+func getObjectProperty(p unsafe.Pointer, name string) (interface{}, error) {
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+	v := C.getProperty(p, cName)
+	if v == nil {
+		return nil, fmt.Errorf("Property not found")
+	}
+	defer C.freeGValue(v)
+
+	return goValue(v)
+}
+
+func setObjectProperty(p unsafe.Pointer, name string, val interface{}) error {
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+	v := C.newPropertyValue(p, cName)
+	if v == nil {
+		return fmt.Errorf("Property not found")
+	}
+	defer C.freeGValue(v)
+
+	if err := setGValue(v, val); err != nil {
+		return err
+	}
+	C.setProperty(p, cName, v)
+	return nil
+}
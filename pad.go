@@ -0,0 +1,246 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gst
+
+// #cgo pkg-config: gobject-2.0 gstreamer-1.0
+// #include <stdlib.h>
+// #include <gst/gst.h>
+// const char* getPadName(void* pad)
+// {
+//   return GST_PAD_NAME(pad);
+// }
+// GstPadDirection getPadDirection(void* pad)
+// {
+//   return GST_PAD_DIRECTION(pad);
+// }
+// GstPadPresence getPadPresence(void* pad)
+// {
+//   GstPadTemplate* tmpl = GST_PAD_PAD_TEMPLATE(pad);
+//   if (tmpl == NULL)
+//     return GST_PAD_ALWAYS;
+//   return GST_PAD_TEMPLATE_PRESENCE(tmpl);
+// }
+// char* capsToString(GstCaps* caps)
+// {
+//   if (caps == NULL)
+//     return NULL;
+//   char* str = gst_caps_to_string(caps);
+//   gst_caps_unref(caps);
+//   return str;
+// }
+// char* getPadCurrentCaps(void* pad)
+// {
+//   return capsToString(gst_pad_get_current_caps(pad));
+// }
+// char* getPadAllowedCaps(void* pad)
+// {
+//   return capsToString(gst_pad_get_allowed_caps(pad));
+// }
+// GstPad* getPadPeer(void* pad)
+// {
+//   return gst_pad_get_peer(pad);
+// }
+// GstElement* getPadParentElement(void* pad)
+// {
+//   return gst_pad_get_parent_element(pad);
+// }
+// GstPad* getStaticPad(void* element, const char* name)
+// {
+//   return gst_element_get_static_pad(element, name);
+// }
+// GstPad* requestPad(void* element, const char* name)
+// {
+// #if GST_CHECK_VERSION(1, 20, 0)
+//   return gst_element_request_pad_simple(element, name);
+// #else
+//   return gst_element_get_request_pad(element, name);
+// #endif
+// }
+// void releaseRequestPad(void* element, void* pad)
+// {
+//   gst_element_release_request_pad(element, pad);
+// }
+// GstPad** getAllPads(void* element)
+// {
+//   GPtrArray* pads = g_ptr_array_new_with_free_func(gst_object_unref);
+//   GstIterator* it = gst_element_iterate_pads(element);
+//
+//   for (gboolean done = FALSE; !done;)
+//   {
+//     GValue val = G_VALUE_INIT;
+//     switch (gst_iterator_next(it, &val))
+//     {
+//       case GST_ITERATOR_OK:
+//       {
+//         g_ptr_array_add(pads, gst_object_ref(g_value_get_object(&val)));
+//         g_value_unset(&val);
+//         break;
+//       }
+//       case GST_ITERATOR_RESYNC:
+//       {
+//         // Pads are added or removed during the iteration.
+//         g_ptr_array_set_size(pads, 0);
+//         gst_iterator_resync(it);
+//         break;
+//       }
+//       default:
+//       {
+//         done = TRUE;
+//         break;
+//       }
+//     }
+//   }
+//   gst_iterator_free(it);
+//   g_ptr_array_set_free_func(pads, NULL);
+//   g_ptr_array_add(pads, NULL);
+//   return (GstPad**)g_ptr_array_free(pads, FALSE);
+// }
+// GstPad* padAt(GstPad** pads, const int i)
+// {
+//   return pads[i];
+// }
+import "C"
+
+import (
+	"fmt"
+	"runtime"
+	"unsafe"
+)
+
+// Pad is a wrapper of GstPad.
+type Pad struct {
+	p unsafe.Pointer
+}
+
+// NewPad creates a new GStreamer pad wrapper from given raw pointer.
+// The wrapper takes the ownership of the reference.
+func NewPad(p unsafe.Pointer) *Pad {
+	pad := &Pad{p: p}
+	runtime.SetFinalizer(pad, finalizePad)
+	return pad
+}
+
+func newPadRef(p unsafe.Pointer) *Pad {
+	C.gst_object_ref(C.gpointer(p))
+	return NewPad(p)
+}
+
+func finalizePad(p *Pad) {
+	C.gst_object_unref(C.gpointer(p.p))
+}
+
+// UnsafePointer returns the raw pointer of the pad.
+func (p *Pad) UnsafePointer() unsafe.Pointer {
+	return p.p
+}
+
+// Name returns the name of the pad.
+func (p *Pad) Name() string {
+	return C.GoString(C.getPadName(p.p))
+}
+
+// Direction returns the direction of the pad.
+func (p *Pad) Direction() PadDirection {
+	return PadDirection(C.getPadDirection(p.p))
+}
+
+// Presence returns the availability of the pad defined by its template.
+// The pad without template is treated as PadAlways.
+func (p *Pad) Presence() PadPresence {
+	return PadPresence(C.getPadPresence(p.p))
+}
+
+// CurrentCaps returns the string representation of the negotiated caps.
+// Error is returned if the caps is not negotiated yet.
+func (p *Pad) CurrentCaps() (string, error) {
+	return goCapsString(C.getPadCurrentCaps(p.p))
+}
+
+// AllowedCaps returns the string representation of the caps allowed by
+// both of the pad and its peer.
+// Error is returned if the pad is not linked.
+func (p *Pad) AllowedCaps() (string, error) {
+	return goCapsString(C.getPadAllowedCaps(p.p))
+}
+
+// Peer returns the pad linked to the pad.
+func (p *Pad) Peer() (*Pad, error) {
+	peer := C.getPadPeer(p.p)
+	if peer == nil {
+		return nil, fmt.Errorf("Pad is not linked")
+	}
+	return NewPad(unsafe.Pointer(peer)), nil
+}
+
+// ParentElement returns the element which has the pad.
+func (p *Pad) ParentElement() (*Element, error) {
+	e := C.getPadParentElement(p.p)
+	if e == nil {
+		return nil, fmt.Errorf("Pad has no parent element")
+	}
+	return NewElement(unsafe.Pointer(e)), nil
+}
+
+// StaticPad returns the always pad of the element by the name.
+func (s *Element) StaticPad(name string) (*Pad, error) {
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+	p := C.getStaticPad(s.UnsafePointer(), cName)
+	if p == nil {
+		return nil, fmt.Errorf("Failed to get pad %s", name)
+	}
+	return NewPad(unsafe.Pointer(p)), nil
+}
+
+// RequestPad requests a new pad by the name or the template name like "src_%u".
+// Requested pad must be released by ReleaseRequestPad.
+func (s *Element) RequestPad(name string) (*Pad, error) {
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+	p := C.requestPad(s.UnsafePointer(), cName)
+	if p == nil {
+		return nil, fmt.Errorf("Failed to request pad %s", name)
+	}
+	return NewPad(unsafe.Pointer(p)), nil
+}
+
+// ReleaseRequestPad releases the pad requested by RequestPad.
+func (s *Element) ReleaseRequestPad(p *Pad) {
+	C.releaseRequestPad(s.UnsafePointer(), p.UnsafePointer())
+}
+
+// Pads returns all pads of the element.
+func (s *Element) Pads() []*Pad {
+	var ret []*Pad
+	ps := C.getAllPads(s.UnsafePointer())
+	defer C.g_free(C.gpointer(ps))
+
+	for i := 0; ; i++ {
+		p := C.padAt(ps, C.int(i))
+		if p == nil {
+			break
+		}
+		ret = append(ret, NewPad(unsafe.Pointer(p)))
+	}
+	return ret
+}
+
+func goCapsString(str *C.char) (string, error) {
+	if str == nil {
+		return "", fmt.Errorf("Caps is not available")
+	}
+	defer C.g_free(C.gpointer(str))
+	return C.GoString(str), nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gst
+
+import (
+	"runtime"
+	"testing"
+)
+
+func TestStaticPad(t *testing.T) {
+	e, err := NewElementFromFactory("queue", "q")
+	if err != nil {
+		t.Fatalf("Failed to create element: %v", err)
+	}
+
+	p, err := e.StaticPad("src")
+	if err != nil {
+		t.Fatalf("Failed to get pad: %v", err)
+	}
+	if n := p.Name(); n != "src" {
+		t.Errorf("Pad name must be \"src\", but got %s", n)
+	}
+	if d := p.Direction(); d != PadSrc {
+		t.Errorf("Pad direction must be PadSrc, but got %s", d)
+	}
+	if pr := p.Presence(); pr != PadAlways {
+		t.Errorf("Pad presence must be PadAlways, but got %s", pr)
+	}
+	if _, err := p.Peer(); err == nil {
+		t.Error("Peer of unlinked pad must return error")
+	}
+	if _, err := p.CurrentCaps(); err == nil {
+		t.Error("CurrentCaps of not negotiated pad must return error")
+	}
+	parent, err := p.ParentElement()
+	if err != nil {
+		t.Fatalf("Failed to get parent element: %v", err)
+	}
+	if name, _ := parent.GetProperty("name"); name != "q" {
+		t.Errorf("Parent element name must be \"q\", but got %v", name)
+	}
+
+	if _, err := e.StaticPad("inexistent"); err == nil {
+		t.Error("StaticPad with inexistent name must fail")
+	}
+	if n := len(e.Pads()); n != 2 {
+		t.Errorf("queue must have 2 pads, but got %d", n)
+	}
+}
+
+func TestRequestPad(t *testing.T) {
+	e, err := NewElementFromFactory("tee", "")
+	if err != nil {
+		t.Fatalf("Failed to create element: %v", err)
+	}
+
+	p, err := e.RequestPad("src_%u")
+	if err != nil {
+		t.Fatalf("Failed to request pad: %v", err)
+	}
+	if n := p.Name(); n != "src_0" {
+		t.Errorf("Pad name must be \"src_0\", but got %s", n)
+	}
+	if pr := p.Presence(); pr != PadRequest {
+		t.Errorf("Pad presence must be PadRequest, but got %s", pr)
+	}
+	if n := len(e.Pads()); n != 2 {
+		t.Errorf("tee must have 2 pads after request, but got %d", n)
+	}
+
+	// Any used objects must not finalized
+	runtime.GC()
+
+	e.ReleaseRequestPad(p)
+	if n := len(e.Pads()); n != 1 {
+		t.Errorf("tee must have 1 pad after release, but got %d", n)
+	}
+
+	if _, err := e.RequestPad("inexistent_%u"); err == nil {
+		t.Error("RequestPad with inexistent template must fail")
+	}
+}
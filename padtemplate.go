@@ -0,0 +1,81 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gst
+
+import (
+	"fmt"
+)
+
+// PadDirection is a direction of the pad.
+type PadDirection uint8
+
+const (
+	// PadUnknown states that the direction is unknown.
+	PadUnknown PadDirection = iota
+	// PadSrc states that the pad is a source pad.
+	PadSrc
+	// PadSink states that the pad is a sink pad.
+	PadSink
+)
+
+// String returns string representation of the PadDirection.
+func (d PadDirection) String() string {
+	switch d {
+	case PadUnknown:
+		return "PadUnknown"
+	case PadSrc:
+		return "PadSrc"
+	case PadSink:
+		return "PadSink"
+	default:
+		return fmt.Sprintf("Unknown PadDirection (%d)", int(d))
+	}
+}
+
+// PadPresence is an availability of the pad.
+type PadPresence uint8
+
+const (
+	// PadAlways states that the pad is always available.
+	PadAlways PadPresence = iota
+	// PadSometimes states that the pad is created depending on the stream.
+	PadSometimes
+	// PadRequest states that the pad is created on request.
+	PadRequest
+)
+
+// String returns string representation of the PadPresence.
+func (p PadPresence) String() string {
+	switch p {
+	case PadAlways:
+		return "PadAlways"
+	case PadSometimes:
+		return "PadSometimes"
+	case PadRequest:
+		return "PadRequest"
+	default:
+		return fmt.Sprintf("Unknown PadPresence (%d)", int(p))
+	}
+}
+
+// PadTemplate describes a pad which can be created by the element.
+type PadTemplate struct {
+	// Name is a name template of the pad like "src_%u".
+	Name      string
+	Direction PadDirection
+	Presence  PadPresence
+	// Caps is a string representation of the capabilities of the pad.
+	Caps string
+}
@@ -0,0 +1,206 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gst
+
+// #cgo pkg-config: gobject-2.0 gstreamer-1.0
+// #include <stdlib.h>
+// #include <glib-object.h>
+// #include <gst/gst.h>
+// #include "gvalue.h"
+// GParamSpec* findProperty(void* element, const char* name)
+// {
+//   return g_object_class_find_property(G_OBJECT_GET_CLASS(element), name);
+// }
+// GParamSpec** listProperties(void* element, guint* n)
+// {
+//   return g_object_class_list_properties(G_OBJECT_GET_CLASS(element), n);
+// }
+// GParamSpec* paramSpecAt(GParamSpec** pspecs, guint i)
+// {
+//   return pspecs[i];
+// }
+// GValue* getParamSpecDefault(GParamSpec* pspec)
+// {
+//   GValue* value = newGValue();
+//   g_value_init(value, G_PARAM_SPEC_VALUE_TYPE(pspec));
+//   g_value_copy(g_param_spec_get_default_value(pspec), value);
+//   return value;
+// }
+// #define RANGE(T, CAST) \
+//   if (G_IS_PARAM_SPEC_##T(pspec)) \
+//   { \
+//     g_value_init(min, G_PARAM_SPEC_VALUE_TYPE(pspec)); \
+//     g_value_init(max, G_PARAM_SPEC_VALUE_TYPE(pspec)); \
+//     g_value_set_##CAST(min, G_PARAM_SPEC_##T(pspec)->minimum); \
+//     g_value_set_##CAST(max, G_PARAM_SPEC_##T(pspec)->maximum); \
+//     return TRUE; \
+//   }
+// gboolean getParamSpecRange(GParamSpec* pspec, GValue* min, GValue* max)
+// {
+//   RANGE(CHAR, schar);
+//   RANGE(UCHAR, uchar);
+//   RANGE(INT, int);
+//   RANGE(UINT, uint);
+//   RANGE(LONG, long);
+//   RANGE(ULONG, ulong);
+//   RANGE(INT64, int64);
+//   RANGE(UINT64, uint64);
+//   RANGE(FLOAT, float);
+//   RANGE(DOUBLE, double);
+//   return FALSE;
+// }
+// #undef RANGE
+import "C"
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+// ParamFlags is a flags of the property.
+type ParamFlags uint
+
+const (
+	// ParamReadable states that the property is readable.
+	ParamReadable ParamFlags = C.G_PARAM_READABLE
+	// ParamWritable states that the property is writable.
+	ParamWritable ParamFlags = C.G_PARAM_WRITABLE
+	// ParamConstruct states that the property is set on object construction.
+	ParamConstruct ParamFlags = C.G_PARAM_CONSTRUCT
+	// ParamConstructOnly states that the property can be set only on object construction.
+	ParamConstructOnly ParamFlags = C.G_PARAM_CONSTRUCT_ONLY
+	// ParamControllable states that the property can be controlled by GstController.
+	ParamControllable ParamFlags = C.GST_PARAM_CONTROLLABLE
+	// ParamMutableReady states that the property can be changed in StateReady or lower.
+	ParamMutableReady ParamFlags = C.GST_PARAM_MUTABLE_READY
+	// ParamMutablePaused states that the property can be changed in StatePaused or lower.
+	ParamMutablePaused ParamFlags = C.GST_PARAM_MUTABLE_PAUSED
+	// ParamMutablePlaying states that the property can be changed in StatePlaying or lower.
+	ParamMutablePlaying ParamFlags = C.GST_PARAM_MUTABLE_PLAYING
+)
+
+// ParamSpec describes a property of the element.
+type ParamSpec struct {
+	Name  string
+	Nick  string
+	Blurb string
+	// ValueType is a GType name of the property value.
+	ValueType string
+	Flags     ParamFlags
+	// Default is a default value of the property.
+	// It is nil if the value type is not supported by GetProperty.
+	Default interface{}
+	// Min and Max are the range of the numeric property.
+	// They are nil for non-numeric properties.
+	Min interface{}
+	Max interface{}
+	// EnumValues is a list of available values of GEnum typed property.
+	EnumValues []EnumValue
+	// FlagsValues is a list of available bits of GFlags typed property.
+	FlagsValues []FlagsValue
+}
+
+// Readable returns true if the property is readable.
+func (p *ParamSpec) Readable() bool {
+	return p.Flags&ParamReadable != 0
+}
+
+// Writable returns true if the property is writable.
+func (p *ParamSpec) Writable() bool {
+	return p.Flags&ParamWritable != 0
+}
+
+// Controllable returns true if the property can be controlled by GstController.
+func (p *ParamSpec) Controllable() bool {
+	return p.Flags&ParamControllable != 0
+}
+
+// MutablePlaying returns true if the property can be changed in StatePlaying.
+func (p *ParamSpec) MutablePlaying() bool {
+	return p.Flags&ParamMutablePlaying != 0
+}
+
+// FindProperty returns the description of the property.
+func (s *Element) FindProperty(name string) (*ParamSpec, error) {
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+	pspec := C.findProperty(s.UnsafePointer(), cName)
+	if pspec == nil {
+		return nil, fmt.Errorf("Property not found")
+	}
+	return newParamSpec(pspec), nil
+}
+
+// ListProperties returns the descriptions of all properties of the element.
+func (s *Element) ListProperties() []*ParamSpec {
+	var n C.guint
+	pspecs := C.listProperties(s.UnsafePointer(), &n)
+	defer C.g_free(C.gpointer(pspecs))
+
+	ret := make([]*ParamSpec, 0, int(n))
+	for i := C.guint(0); i < n; i++ {
+		ret = append(ret, newParamSpec(C.paramSpecAt(pspecs, i)))
+	}
+	return ret
+}
+
+func newParamSpec(pspec *C.GParamSpec) *ParamSpec {
+	t := pspec.value_type
+	p := &ParamSpec{
+		Name:      C.GoString(C.g_param_spec_get_name(pspec)),
+		Nick:      C.GoString(C.g_param_spec_get_nick(pspec)),
+		Blurb:     C.GoString(C.g_param_spec_get_blurb(pspec)),
+		ValueType: typeName(t),
+		Flags:     ParamFlags(pspec.flags),
+	}
+
+	def := C.getParamSpecDefault(pspec)
+	p.Default, _ = goValue(def)
+	C.freeGValue(def)
+
+	min, max := C.newGValue(), C.newGValue()
+	if C.getParamSpecRange(pspec, min, max) != 0 {
+		p.Min, _ = goValue(min)
+		p.Max, _ = goValue(max)
+	}
+	C.freeGValue(min)
+	C.freeGValue(max)
+
+	switch C.g_type_fundamental(t) {
+	case C.G_TYPE_ENUM:
+		klass := (*C.GEnumClass)(C.g_type_class_ref(t))
+		values := (*[1 << 16]C.GEnumValue)(unsafe.Pointer(klass.values))[:klass.n_values:klass.n_values]
+		for _, v := range values {
+			p.EnumValues = append(p.EnumValues, EnumValue{
+				Value: int(v.value),
+				Name:  C.GoString(v.value_name),
+				Nick:  C.GoString(v.value_nick),
+			})
+		}
+		C.g_type_class_unref(C.gpointer(klass))
+	case C.G_TYPE_FLAGS:
+		klass := (*C.GFlagsClass)(C.g_type_class_ref(t))
+		values := (*[1 << 16]C.GFlagsValue)(unsafe.Pointer(klass.values))[:klass.n_values:klass.n_values]
+		for _, v := range values {
+			p.FlagsValues = append(p.FlagsValues, FlagsValue{
+				Value: uint(v.value),
+				Name:  C.GoString(v.value_name),
+				Nick:  C.GoString(v.value_nick),
+			})
+		}
+		C.g_type_class_unref(C.gpointer(klass))
+	}
+	return p
+}
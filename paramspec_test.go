@@ -0,0 +1,94 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gst
+
+import (
+	"testing"
+
+	"github.com/seqsense/sq-gst-go/internal/dummyelement"
+)
+
+func TestFindProperty(t *testing.T) {
+	e := NewElement(dummyelement.NewWithFactory("queue"))
+
+	p, err := e.FindProperty("max-size-buffers")
+	if err != nil {
+		t.Fatalf("Failed to FindProperty: %v", err)
+	}
+	if p.Name != "max-size-buffers" {
+		t.Errorf("Unexpected name %s", p.Name)
+	}
+	if p.ValueType != "guint" {
+		t.Errorf("Unexpected value type %s, expected guint", p.ValueType)
+	}
+	if !p.Readable() || !p.Writable() {
+		t.Error("queue.max-size-buffers must be readable and writable")
+	}
+	if p.Default != uint(200) {
+		t.Errorf("Unexpected default value %v, expected 200", p.Default)
+	}
+	if p.Min != uint(0) || p.Max != uint(1<<32-1) {
+		t.Errorf("Unexpected range %v-%v", p.Min, p.Max)
+	}
+
+	if _, err := e.FindProperty("inexistent-property"); err == nil {
+		t.Error("FindProperty for inexistent property must fail")
+	}
+}
+
+func TestFindProperty_Enum(t *testing.T) {
+	e := NewElement(dummyelement.NewWithFactory("queue"))
+
+	p, err := e.FindProperty("leaky")
+	if err != nil {
+		t.Fatalf("Failed to FindProperty: %v", err)
+	}
+	var nicks []string
+	for _, v := range p.EnumValues {
+		nicks = append(nicks, v.Nick)
+	}
+	if len(nicks) != 3 || nicks[0] != "no" || nicks[1] != "upstream" || nicks[2] != "downstream" {
+		t.Errorf("Unexpected enum values %v", nicks)
+	}
+	if p.Min != nil || p.Max != nil {
+		t.Errorf("Enum property must not have range, got %v-%v", p.Min, p.Max)
+	}
+}
+
+func TestFindProperty_Controllable(t *testing.T) {
+	e := NewElement(dummyelement.NewWithFactory("volume"))
+
+	p, err := e.FindProperty("volume")
+	if err != nil {
+		t.Fatalf("Failed to FindProperty: %v", err)
+	}
+	if !p.Controllable() || !p.MutablePlaying() {
+		t.Error("volume.volume must be controllable and mutable in playing state")
+	}
+}
+
+func TestListProperties(t *testing.T) {
+	e := NewElement(dummyelement.New())
+
+	names := make(map[string]bool)
+	for _, p := range e.ListProperties() {
+		names[p.Name] = true
+	}
+	for _, n := range []string{"name", "sync", "num-buffers"} {
+		if !names[n] {
+			t.Errorf("ListProperties must contain %s", n)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+/* Copyright 2026 SEQSENSE, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+#include <gst/gst.h>
+
+#include "probe.h"
+
+static GstPadProbeReturn cbPadProbe(GstPad* pad, GstPadProbeInfo* info, gpointer user_data)
+{
+  GstPadProbeReturn ret = goPadProbe(GPOINTER_TO_INT(user_data), pad, info);
+  if (ret == GST_PAD_PROBE_HANDLED &&
+      (GST_PAD_PROBE_INFO_TYPE(info) &
+       (GST_PAD_PROBE_TYPE_BUFFER | GST_PAD_PROBE_TYPE_BUFFER_LIST | GST_PAD_PROBE_TYPE_EVENT_BOTH)) &&
+      GST_PAD_PROBE_INFO_DATA(info) != NULL)
+  {
+    // The probe owns the handled buffer and event.
+    // Go callback holds its own reference obtained by PadProbeInfo.Buffer or Event if needed.
+    gst_mini_object_unref(GST_PAD_PROBE_INFO_DATA(info));
+    GST_PAD_PROBE_INFO_DATA(info) = NULL;
+  }
+  return ret;
+}
+static void cbPadProbeDestroy(gpointer user_data)
+{
+  goPadProbeDestroy(GPOINTER_TO_INT(user_data));
+}
+gulong addPadProbe(void* pad, GstPadProbeType mask, int id)
+{
+  return gst_pad_add_probe(pad, mask, cbPadProbe, GINT_TO_POINTER(id), cbPadProbeDestroy);
+}
+void removePadProbe(void* pad, gulong probe_id)
+{
+  gst_pad_remove_probe(pad, probe_id);
+}
+GstPadProbeType getProbeInfoType(GstPadProbeInfo* info)
+{
+  return GST_PAD_PROBE_INFO_TYPE(info);
+}
+GstBuffer* getProbeInfoBuffer(GstPadProbeInfo* info)
+{
+  if (!(GST_PAD_PROBE_INFO_TYPE(info) & GST_PAD_PROBE_TYPE_BUFFER))
+    return NULL;
+  return GST_PAD_PROBE_INFO_BUFFER(info);
+}
+GstBufferList* getProbeInfoBufferList(GstPadProbeInfo* info)
+{
+  if (!(GST_PAD_PROBE_INFO_TYPE(info) & GST_PAD_PROBE_TYPE_BUFFER_LIST))
+    return NULL;
+  return GST_PAD_PROBE_INFO_BUFFER_LIST(info);
+}
+GstEvent* getProbeInfoEvent(GstPadProbeInfo* info)
+{
+  if (!(GST_PAD_PROBE_INFO_TYPE(info) & GST_PAD_PROBE_TYPE_EVENT_BOTH))
+    return NULL;
+  return GST_PAD_PROBE_INFO_EVENT(info);
+}
+GstEventType getProbeInfoEventType(GstPadProbeInfo* info)
+{
+  GstEvent* event = getProbeInfoEvent(info);
+  if (event == NULL)
+    return GST_EVENT_UNKNOWN;
+  return GST_EVENT_TYPE(event);
+}
+GstQueryType getProbeInfoQueryType(GstPadProbeInfo* info)
+{
+  if (!(GST_PAD_PROBE_INFO_TYPE(info) & GST_PAD_PROBE_TYPE_QUERY_BOTH))
+    return GST_QUERY_UNKNOWN;
+  GstQuery* query = GST_PAD_PROBE_INFO_QUERY(info);
+  if (query == NULL)
+    return GST_QUERY_UNKNOWN;
+  return GST_QUERY_TYPE(query);
+}
+gsize copyBufferData(GstBuffer* buffer, void* dest, gsize size)
+{
+  return gst_buffer_extract(buffer, 0, dest, size);
+}
@@ -0,0 +1,260 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gst
+
+// #cgo pkg-config: gobject-2.0 gstreamer-1.0
+// #include "probe.h"
+import "C"
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+)
+
+// PadProbeType is a bit mask of the data and the scheduling mode handled by the probe.
+type PadProbeType uint
+
+const (
+	// PadProbeTypeIdle probes the pad when it is idle.
+	PadProbeTypeIdle PadProbeType = C.GST_PAD_PROBE_TYPE_IDLE
+	// PadProbeTypeBlock blocks the dataflow on the pad while the probe is installed.
+	PadProbeTypeBlock PadProbeType = C.GST_PAD_PROBE_TYPE_BLOCK
+	// PadProbeTypeBuffer probes buffers.
+	PadProbeTypeBuffer PadProbeType = C.GST_PAD_PROBE_TYPE_BUFFER
+	// PadProbeTypeBufferList probes buffer lists.
+	PadProbeTypeBufferList PadProbeType = C.GST_PAD_PROBE_TYPE_BUFFER_LIST
+	// PadProbeTypeEventDownstream probes downstream events.
+	PadProbeTypeEventDownstream PadProbeType = C.GST_PAD_PROBE_TYPE_EVENT_DOWNSTREAM
+	// PadProbeTypeEventUpstream probes upstream events.
+	PadProbeTypeEventUpstream PadProbeType = C.GST_PAD_PROBE_TYPE_EVENT_UPSTREAM
+	// PadProbeTypeEventFlush probes flush events.
+	PadProbeTypeEventFlush PadProbeType = C.GST_PAD_PROBE_TYPE_EVENT_FLUSH
+	// PadProbeTypeQueryDownstream probes downstream queries.
+	PadProbeTypeQueryDownstream PadProbeType = C.GST_PAD_PROBE_TYPE_QUERY_DOWNSTREAM
+	// PadProbeTypeQueryUpstream probes upstream queries.
+	PadProbeTypeQueryUpstream PadProbeType = C.GST_PAD_PROBE_TYPE_QUERY_UPSTREAM
+	// PadProbeTypePush probes push mode scheduling.
+	PadProbeTypePush PadProbeType = C.GST_PAD_PROBE_TYPE_PUSH
+	// PadProbeTypePull probes pull mode scheduling.
+	PadProbeTypePull PadProbeType = C.GST_PAD_PROBE_TYPE_PULL
+
+	// PadProbeTypeBlocking probes and blocks on idle and data.
+	PadProbeTypeBlocking PadProbeType = C.GST_PAD_PROBE_TYPE_BLOCKING
+	// PadProbeTypeDataDownstream probes all downstream data.
+	PadProbeTypeDataDownstream PadProbeType = C.GST_PAD_PROBE_TYPE_DATA_DOWNSTREAM
+	// PadProbeTypeDataUpstream probes all upstream data.
+	PadProbeTypeDataUpstream PadProbeType = C.GST_PAD_PROBE_TYPE_DATA_UPSTREAM
+	// PadProbeTypeDataBoth probes all data in both directions.
+	PadProbeTypeDataBoth PadProbeType = C.GST_PAD_PROBE_TYPE_DATA_BOTH
+	// PadProbeTypeBlockDownstream probes and blocks downstream data.
+	PadProbeTypeBlockDownstream PadProbeType = C.GST_PAD_PROBE_TYPE_BLOCK_DOWNSTREAM
+	// PadProbeTypeBlockUpstream probes and blocks upstream data.
+	PadProbeTypeBlockUpstream PadProbeType = C.GST_PAD_PROBE_TYPE_BLOCK_UPSTREAM
+	// PadProbeTypeEventBoth probes events in both directions.
+	PadProbeTypeEventBoth PadProbeType = C.GST_PAD_PROBE_TYPE_EVENT_BOTH
+	// PadProbeTypeQueryBoth probes queries in both directions.
+	PadProbeTypeQueryBoth PadProbeType = C.GST_PAD_PROBE_TYPE_QUERY_BOTH
+	// PadProbeTypeAll probes all data and events in both directions.
+	PadProbeTypeAll PadProbeType = C.GST_PAD_PROBE_TYPE_ALL_BOTH
+	// PadProbeTypeScheduling probes both push and pull mode scheduling.
+	PadProbeTypeScheduling PadProbeType = C.GST_PAD_PROBE_TYPE_SCHEDULING
+)
+
+// PadProbeReturn is an action to be taken on the data passed to the probe.
+type PadProbeReturn int
+
+const (
+	// PadProbeDrop drops the data. For queries, the query is answered as failed.
+	PadProbeDrop PadProbeReturn = C.GST_PAD_PROBE_DROP
+	// PadProbeOK passes the data. Blocking probes keep blocking.
+	PadProbeOK PadProbeReturn = C.GST_PAD_PROBE_OK
+	// PadProbeRemove removes the probe and passes the data.
+	PadProbeRemove PadProbeReturn = C.GST_PAD_PROBE_REMOVE
+	// PadProbePass passes the data without unblocking the blocking probe.
+	PadProbePass PadProbeReturn = C.GST_PAD_PROBE_PASS
+	// PadProbeHandled marks the data as handled by the probe.
+	// The data is not passed to the peer.
+	// Buffers and events are released after the callback returns, so the callback
+	// must keep the reference obtained by PadProbeInfo.Buffer or PadProbeInfo.Event
+	// to use them later. Queries are answered as succeeded.
+	PadProbeHandled PadProbeReturn = C.GST_PAD_PROBE_HANDLED
+)
+
+// String returns string representation of the PadProbeReturn.
+func (r PadProbeReturn) String() string {
+	switch r {
+	case PadProbeDrop:
+		return "Drop"
+	case PadProbeOK:
+		return "OK"
+	case PadProbeRemove:
+		return "Remove"
+	case PadProbePass:
+		return "Pass"
+	case PadProbeHandled:
+		return "Handled"
+	}
+	return "Unknown"
+}
+
+// PadProbeID is an identifier of the probe attached to the pad.
+type PadProbeID uint64
+
+// PadProbeInfo is the data passed to the probe callback.
+// It is valid only during the callback.
+type PadProbeInfo struct {
+	info *C.GstPadProbeInfo
+}
+
+// Type returns the type of the probe which is triggered.
+func (i *PadProbeInfo) Type() PadProbeType {
+	return PadProbeType(C.getProbeInfoType(i.info))
+}
+
+// ID returns the identifier of the probe.
+func (i *PadProbeInfo) ID() PadProbeID {
+	return PadProbeID(i.info.id)
+}
+
+// Offset returns the offset of pull mode scheduling.
+func (i *PadProbeInfo) Offset() uint64 {
+	return uint64(i.info.offset)
+}
+
+// Size returns the size of pull mode scheduling.
+func (i *PadProbeInfo) Size() uint {
+	return uint(i.info.size)
+}
+
+// BufferBytes returns a copy of the buffer data.
+// nil is returned if the probe is not triggered by a buffer.
+func (i *PadProbeInfo) BufferBytes() []byte {
+	buf := C.getProbeInfoBuffer(i.info)
+	if buf == nil {
+		return nil
+	}
+	return goBufferBytes(buf)
+}
+
+// Buffer returns the buffer.
+// nil is returned if the probe is not triggered by a buffer.
+// Modifying the returned buffer doesn't affect the stream.
+func (i *PadProbeInfo) Buffer() *Buffer {
+	buf := C.getProbeInfoBuffer(i.info)
+	if buf == nil {
+		return nil
+	}
+	return newBufferRef(buf)
+}
+
+// BufferListBytes returns copies of the data of the buffers in the buffer list.
+// nil is returned if the probe is not triggered by a buffer list.
+func (i *PadProbeInfo) BufferListBytes() [][]byte {
+	list := C.getProbeInfoBufferList(i.info)
+	if list == nil {
+		return nil
+	}
+	n := C.gst_buffer_list_length(list)
+	ret := make([][]byte, 0, int(n))
+	for j := C.guint(0); j < n; j++ {
+		ret = append(ret, goBufferBytes(C.gst_buffer_list_get(list, j)))
+	}
+	return ret
+}
+
+// EventType returns the type of the event.
+// EventUnknown is returned if the probe is not triggered by an event.
+func (i *PadProbeInfo) EventType() EventType {
+	return EventType(C.getProbeInfoEventType(i.info))
+}
+
+// Event returns the event.
+// nil is returned if the probe is not triggered by an event.
+func (i *PadProbeInfo) Event() *Event {
+	e := C.getProbeInfoEvent(i.info)
+	if e == nil {
+		return nil
+	}
+	return newEventRef(e)
+}
+
+// QueryType returns the type of the query.
+// QueryUnknown is returned if the probe is not triggered by a query.
+func (i *PadProbeInfo) QueryType() QueryType {
+	return QueryType(C.getProbeInfoQueryType(i.info))
+}
+
+func goBufferBytes(buf *C.GstBuffer) []byte {
+	size := C.gst_buffer_get_size(buf)
+	b := make([]byte, int(size))
+	if size == 0 {
+		return b
+	}
+	n := C.copyBufferData(buf, unsafe.Pointer(&b[0]), size)
+	return b[:int(n)]
+}
+
+// PadProbeCallback is called when the data matching the probe mask is passed
+// through the pad.
+type PadProbeCallback func(*Pad, *PadProbeInfo) PadProbeReturn
+
+var (
+	probes     = make(map[int32]PadProbeCallback)
+	probeMutex sync.RWMutex
+	probeIDCnt = int32(0)
+)
+
+// AddProbe attaches the probe to the pad.
+// Callback is called from the streaming thread.
+// Idle probe may be called immediately from AddProbe if the pad is idle.
+// In this case, zero is returned if the callback returned PadProbeRemove.
+func (p *Pad) AddProbe(mask PadProbeType, f PadProbeCallback) (PadProbeID, error) {
+	if f == nil {
+		return 0, fmt.Errorf("Callback must not be nil")
+	}
+	id := atomic.AddInt32(&probeIDCnt, 1)
+	probeMutex.Lock()
+	probes[id] = f
+	probeMutex.Unlock()
+
+	return PadProbeID(C.addPadProbe(p.p, C.GstPadProbeType(mask), C.int(id))), nil
+}
+
+// RemoveProbe removes the probe from the pad.
+func (p *Pad) RemoveProbe(id PadProbeID) {
+	C.removePadProbe(p.p, C.gulong(id))
+}
+
+//export goPadProbe
+func goPadProbe(id C.int, pad *C.GstPad, info *C.GstPadProbeInfo) C.GstPadProbeReturn {
+	probeMutex.RLock()
+	f, ok := probes[int32(id)]
+	probeMutex.RUnlock()
+	if !ok {
+		log.Printf("Unhandled pad probe (id: %d)", int(id))
+		return C.GST_PAD_PROBE_OK
+	}
+	return C.GstPadProbeReturn(f(newPadRef(unsafe.Pointer(pad)), &PadProbeInfo{info: info}))
+}
+
+//export goPadProbeDestroy
+func goPadProbeDestroy(id C.int) {
+	probeMutex.Lock()
+	delete(probes, int32(id))
+	probeMutex.Unlock()
+}
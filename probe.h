@@ -0,0 +1,34 @@
+/* Copyright 2026 SEQSENSE, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+#ifndef PROBE_H
+#define PROBE_H
+
+#include <gst/gst.h>
+
+extern GstPadProbeReturn goPadProbe(int id, GstPad* pad, GstPadProbeInfo* info);
+extern void goPadProbeDestroy(int id);
+
+gulong addPadProbe(void* pad, GstPadProbeType mask, int id);
+void removePadProbe(void* pad, gulong probe_id);
+GstPadProbeType getProbeInfoType(GstPadProbeInfo* info);
+GstBuffer* getProbeInfoBuffer(GstPadProbeInfo* info);
+GstBufferList* getProbeInfoBufferList(GstPadProbeInfo* info);
+GstEvent* getProbeInfoEvent(GstPadProbeInfo* info);
+GstEventType getProbeInfoEventType(GstPadProbeInfo* info);
+GstQueryType getProbeInfoQueryType(GstPadProbeInfo* info);
+gsize copyBufferData(GstBuffer* buffer, void* dest, gsize size);
+
+#endif  // PROBE_H
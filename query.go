@@ -0,0 +1,218 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gst
+
+// #cgo pkg-config: gobject-2.0 gstreamer-1.0
+// #include <gst/gst.h>
+// gboolean queryPosition(void* element, GstFormat format, gint64* val)
+// {
+//   return gst_element_query_position(element, format, val);
+// }
+// gboolean queryDuration(void* element, GstFormat format, gint64* val)
+// {
+//   return gst_element_query_duration(element, format, val);
+// }
+// gboolean queryConvert(void* element, GstFormat src_format, gint64 src_val, GstFormat dest_format, gint64* dest_val)
+// {
+//   return gst_element_query_convert(element, src_format, src_val, dest_format, dest_val);
+// }
+// gboolean queryLatency(void* element, gboolean* live, GstClockTime* min, GstClockTime* max)
+// {
+//   GstQuery* query = gst_query_new_latency();
+//   gboolean ret = gst_element_query(element, query);
+//   if (ret)
+//     gst_query_parse_latency(query, live, min, max);
+//   gst_query_unref(query);
+//   return ret;
+// }
+// gboolean querySeeking(void* element, GstFormat* format, gboolean* seekable, gint64* start, gint64* end)
+// {
+//   GstQuery* query = gst_query_new_seeking(*format);
+//   gboolean ret = gst_element_query(element, query);
+//   if (ret)
+//     gst_query_parse_seeking(query, format, seekable, start, end);
+//   gst_query_unref(query);
+//   return ret;
+// }
+import "C"
+
+import (
+	"fmt"
+	"time"
+)
+
+// Format is a format of the values like position and duration.
+type Format int
+
+const (
+	// FormatUndefined is an undefined format.
+	FormatUndefined Format = C.GST_FORMAT_UNDEFINED
+	// FormatDefault is the default format of the stream like samples for audio and frames for video.
+	FormatDefault Format = C.GST_FORMAT_DEFAULT
+	// FormatBytes is the number of bytes.
+	FormatBytes Format = C.GST_FORMAT_BYTES
+	// FormatTime is the time in nanoseconds.
+	FormatTime Format = C.GST_FORMAT_TIME
+	// FormatBuffers is the number of buffers.
+	FormatBuffers Format = C.GST_FORMAT_BUFFERS
+	// FormatPercent is the percentage of the stream scaled by 10000 (1000000 for 100%).
+	FormatPercent Format = C.GST_FORMAT_PERCENT
+)
+
+// String returns the name of the Format.
+func (f Format) String() string {
+	return C.GoString(C.gst_format_get_name(C.GstFormat(f)))
+}
+
+// clockTimeNone is GST_CLOCK_TIME_NONE represented as a signed value.
+const clockTimeNone = -1
+
+// QueryType is a type of GstQuery.
+type QueryType uint
+
+const (
+	// QueryUnknown is an unknown query type.
+	QueryUnknown QueryType = C.GST_QUERY_UNKNOWN
+	// QueryPosition queries the current playback position.
+	QueryPosition QueryType = C.GST_QUERY_POSITION
+	// QueryDuration queries the total duration of the stream.
+	QueryDuration QueryType = C.GST_QUERY_DURATION
+	// QueryLatency queries the latency of the stream.
+	QueryLatency QueryType = C.GST_QUERY_LATENCY
+	// QueryJitter queries the current jitter.
+	QueryJitter QueryType = C.GST_QUERY_JITTER
+	// QueryRate queries the current playback rate.
+	QueryRate QueryType = C.GST_QUERY_RATE
+	// QuerySeeking queries whether and how the stream is seekable.
+	QuerySeeking QueryType = C.GST_QUERY_SEEKING
+	// QuerySegment queries the currently configured segment.
+	QuerySegment QueryType = C.GST_QUERY_SEGMENT
+	// QueryConvert converts a value between formats.
+	QueryConvert QueryType = C.GST_QUERY_CONVERT
+	// QueryFormats queries the supported formats.
+	QueryFormats QueryType = C.GST_QUERY_FORMATS
+	// QueryBuffering queries the buffering status.
+	QueryBuffering QueryType = C.GST_QUERY_BUFFERING
+	// QueryCustom is an application specific query.
+	QueryCustom QueryType = C.GST_QUERY_CUSTOM
+	// QueryURI queries the URI of the source or sink.
+	QueryURI QueryType = C.GST_QUERY_URI
+	// QueryAllocation negotiates the buffer allocation parameters.
+	QueryAllocation QueryType = C.GST_QUERY_ALLOCATION
+	// QueryScheduling queries the scheduling properties of the peer pad.
+	QueryScheduling QueryType = C.GST_QUERY_SCHEDULING
+	// QueryAcceptCaps checks whether the caps are acceptable.
+	QueryAcceptCaps QueryType = C.GST_QUERY_ACCEPT_CAPS
+	// QueryCaps queries the caps supported by the pad.
+	QueryCaps QueryType = C.GST_QUERY_CAPS
+	// QueryDrain waits until all buffers are returned to the pool.
+	QueryDrain QueryType = C.GST_QUERY_DRAIN
+	// QueryContext queries a context from the peer elements.
+	QueryContext QueryType = C.GST_QUERY_CONTEXT
+)
+
+// String returns the name of the QueryType.
+func (t QueryType) String() string {
+	return C.GoString(C.gst_query_type_get_name(C.GstQueryType(t)))
+}
+
+// Latency is a result of the latency query.
+type Latency struct {
+	// Live is true if the pipeline contains live elements.
+	Live bool
+	Min  time.Duration
+	// Max is negative if the maximum latency is unlimited.
+	Max time.Duration
+}
+
+// SeekingRange is a result of the seeking query.
+type SeekingRange struct {
+	Format   Format
+	Seekable bool
+	// Start and End are the seekable range in the format.
+	// End is -1 if it is unknown.
+	Start, End int64
+}
+
+// QueryPosition returns the current position of the stream in the format.
+// Error is returned if the query is not answered or the position is unknown.
+func (s *Element) QueryPosition(format Format) (int64, error) {
+	var val C.gint64
+	if C.queryPosition(s.p, C.GstFormat(format), &val) == 0 || val == clockTimeNone {
+		return 0, fmt.Errorf("Position query is not answered")
+	}
+	return int64(val), nil
+}
+
+// QueryDuration returns the total duration of the stream in the format.
+// Error is returned if the query is not answered or the duration is unknown.
+func (s *Element) QueryDuration(format Format) (int64, error) {
+	var val C.gint64
+	if C.queryDuration(s.p, C.GstFormat(format), &val) == 0 || val == clockTimeNone {
+		return 0, fmt.Errorf("Duration query is not answered")
+	}
+	return int64(val), nil
+}
+
+// Position returns the current position of the stream.
+func (s *Element) Position() (time.Duration, error) {
+	val, err := s.QueryPosition(FormatTime)
+	return time.Duration(val), err
+}
+
+// Duration returns the total duration of the stream.
+func (s *Element) Duration() (time.Duration, error) {
+	val, err := s.QueryDuration(FormatTime)
+	return time.Duration(val), err
+}
+
+// QueryConvert converts the value from the source format to the destination format.
+func (s *Element) QueryConvert(srcFormat Format, srcVal int64, destFormat Format) (int64, error) {
+	var val C.gint64
+	if C.queryConvert(s.p, C.GstFormat(srcFormat), C.gint64(srcVal), C.GstFormat(destFormat), &val) == 0 {
+		return 0, fmt.Errorf("Convert query from %s to %s is not answered", srcFormat, destFormat)
+	}
+	return int64(val), nil
+}
+
+// QueryLatency returns the latency of the stream.
+func (s *Element) QueryLatency() (*Latency, error) {
+	var live C.gboolean
+	var min, max C.GstClockTime
+	if C.queryLatency(s.p, &live, &min, &max) == 0 {
+		return nil, fmt.Errorf("Latency query is not answered")
+	}
+	return &Latency{
+		Live: live != 0,
+		Min:  time.Duration(min),
+		Max:  time.Duration(int64(max)),
+	}, nil
+}
+
+// QuerySeeking returns the seekable range of the stream in the format.
+func (s *Element) QuerySeeking(format Format) (*SeekingRange, error) {
+	f := C.GstFormat(format)
+	var seekable C.gboolean
+	var start, end C.gint64
+	if C.querySeeking(s.p, &f, &seekable, &start, &end) == 0 {
+		return nil, fmt.Errorf("Seeking query is not answered")
+	}
+	return &SeekingRange{
+		Format:   Format(f),
+		Seekable: seekable != 0,
+		Start:    int64(start),
+		End:      int64(end),
+	}, nil
+}
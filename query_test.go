@@ -0,0 +1,79 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gst
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestElement_QueryDuration(t *testing.T) {
+	data := make([]byte, 1000)
+	path := filepath.Join(t.TempDir(), "data")
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+
+	p, err := NewPipeline("")
+	if err != nil {
+		t.Fatalf("Failed to create pipeline: %v", err)
+	}
+	es := mustNewElements(t, "filesrc", "fakesink")
+	if err := es[0].SetProperty("location", path); err != nil {
+		t.Fatalf("Failed to set location: %v", err)
+	}
+	if err := p.Add(es...); err != nil {
+		t.Fatalf("Failed to add elements: %v", err)
+	}
+	if err := es[0].Link(es[1]); err != nil {
+		t.Fatalf("Failed to link elements: %v", err)
+	}
+	if _, err := p.SetState(StatePaused); err != nil {
+		t.Fatalf("Failed to pause pipeline: %v", err)
+	}
+	defer p.SetState(StateNull)
+	if _, _, _, err := p.GetState(time.Second); err != nil {
+		t.Fatalf("Failed to get state: %v", err)
+	}
+
+	n, err := p.QueryDuration(FormatBytes)
+	if err != nil {
+		t.Fatalf("Failed to query duration: %v", err)
+	}
+	if n != int64(len(data)) {
+		t.Errorf("Expected duration %d bytes, got %d", len(data), n)
+	}
+}
+
+func TestElement_QueryNotAnswered(t *testing.T) {
+	e := mustNewElements(t, "fakesink")[0]
+	if e.State() != StateNull {
+		t.Fatalf("Element must be in NULL state, but %s", e.State())
+	}
+	if _, err := e.QueryPosition(FormatTime); err == nil {
+		t.Error("Position query on the element in NULL state must fail")
+	}
+	if _, err := e.Position(); err == nil {
+		t.Error("Position on the element in NULL state must fail")
+	}
+	if _, err := e.QueryDuration(FormatTime); err == nil {
+		t.Error("Duration query on the element in NULL state must fail")
+	}
+	if _, err := e.Duration(); err == nil {
+		t.Error("Duration on the element in NULL state must fail")
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gst
+
+// #cgo pkg-config: gobject-2.0 gstreamer-1.0
+// #include <stdlib.h>
+// #include <gst/gst.h>
+// GList* listPlugins()
+// {
+//   return gst_registry_get_plugin_list(gst_registry_get());
+// }
+// GstPlugin* findPlugin(const char* name)
+// {
+//   return gst_registry_find_plugin(gst_registry_get(), name);
+// }
+// GList* listPluginFeatures(const char* name)
+// {
+//   return gst_registry_get_feature_list_by_plugin(gst_registry_get(), name);
+// }
+// gboolean isPluginBlacklisted(GstPlugin* plugin)
+// {
+//   return GST_OBJECT_FLAG_IS_SET(plugin, GST_PLUGIN_FLAG_BLACKLISTED);
+// }
+// const char* getFeatureTypeName(void* feature)
+// {
+//   return G_OBJECT_TYPE_NAME(feature);
+// }
+// const char* getFeaturePluginName(void* feature)
+// {
+//   return gst_plugin_feature_get_plugin_name(feature);
+// }
+// GList* listElementFactories()
+// {
+//   GList* l = gst_element_factory_list_get_elements(GST_ELEMENT_FACTORY_TYPE_ANY, GST_RANK_NONE);
+//   return g_list_sort(l, gst_plugin_feature_rank_compare_func);
+// }
+// GList* filterElementFactoriesByCaps(const char* caps_str, GstPadDirection direction, gboolean subset, gboolean* ok)
+// {
+//   GstCaps* caps = gst_caps_from_string(caps_str);
+//   if (caps == NULL)
+//   {
+//     *ok = FALSE;
+//     return NULL;
+//   }
+//   *ok = TRUE;
+//   GList* l = listElementFactories();
+//   GList* filtered = gst_element_factory_list_filter(l, caps, direction, subset);
+//   gst_plugin_feature_list_free(l);
+//   gst_caps_unref(caps);
+//   return filtered;
+// }
+import "C"
+
+import (
+	"fmt"
+	"strings"
+	"unsafe"
+)
+
+// Plugin describes a plugin registered to the GStreamer registry.
+type Plugin struct {
+	Name        string
+	Description string
+	Filename    string
+	Version     string
+	License     string
+	// Source is a source module name like "gst-plugins-base".
+	Source string
+	// Package is a package name like "GStreamer Base Plug-ins source release".
+	Package     string
+	Origin      string
+	ReleaseDate string
+	// Blacklisted is true if the plugin failed to load.
+	Blacklisted bool
+}
+
+// PluginFeature describes a feature provided by the plugin.
+type PluginFeature struct {
+	Name string
+	// Type is a GType name of the feature like "GstElementFactory" and "GstTypeFindFactory".
+	Type   string
+	Rank   Rank
+	Plugin string
+}
+
+// Plugins returns all plugins in the registry.
+func Plugins() []*Plugin {
+	list := C.listPlugins()
+	defer C.gst_plugin_list_free(list)
+
+	var ret []*Plugin
+	for l := list; l != nil; l = l.next {
+		ret = append(ret, newPlugin((*C.GstPlugin)(l.data)))
+	}
+	return ret
+}
+
+// BlacklistedPlugins returns plugins failed to load.
+func BlacklistedPlugins() []*Plugin {
+	var ret []*Plugin
+	for _, p := range Plugins() {
+		if p.Blacklisted {
+			ret = append(ret, p)
+		}
+	}
+	return ret
+}
+
+// FindPlugin finds the plugin by the name.
+func FindPlugin(name string) (*Plugin, error) {
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+	p := C.findPlugin(cName)
+	if p == nil {
+		return nil, fmt.Errorf("Plugin %s not found", name)
+	}
+	defer C.gst_object_unref(C.gpointer(p))
+	return newPlugin(p), nil
+}
+
+func newPlugin(p *C.GstPlugin) *Plugin {
+	return &Plugin{
+		Name:        C.GoString(C.gst_plugin_get_name(p)),
+		Description: C.GoString(C.gst_plugin_get_description(p)),
+		Filename:    C.GoString(C.gst_plugin_get_filename(p)),
+		Version:     C.GoString(C.gst_plugin_get_version(p)),
+		License:     C.GoString(C.gst_plugin_get_license(p)),
+		Source:      C.GoString(C.gst_plugin_get_source(p)),
+		Package:     C.GoString(C.gst_plugin_get_package(p)),
+		Origin:      C.GoString(C.gst_plugin_get_origin(p)),
+		ReleaseDate: C.GoString(C.gst_plugin_get_release_date_string(p)),
+		Blacklisted: C.isPluginBlacklisted(p) != 0,
+	}
+}
+
+// Features returns the features provided by the plugin.
+func (p *Plugin) Features() []*PluginFeature {
+	cName := C.CString(p.Name)
+	defer C.free(unsafe.Pointer(cName))
+	list := C.listPluginFeatures(cName)
+	defer C.gst_plugin_feature_list_free(list)
+
+	var ret []*PluginFeature
+	for l := list; l != nil; l = l.next {
+		ret = append(ret, &PluginFeature{
+			Name:   featureName(unsafe.Pointer(l.data)),
+			Type:   C.GoString(C.getFeatureTypeName(unsafe.Pointer(l.data))),
+			Rank:   Rank(C.gst_plugin_feature_get_rank((*C.GstPluginFeature)(l.data))),
+			Plugin: C.GoString(C.getFeaturePluginName(unsafe.Pointer(l.data))),
+		})
+	}
+	return ret
+}
+
+// ElementFactories returns element factories which have all components of
+// the given klass like "Codec/Encoder/Video" sorted by the rank.
+// If klass is empty, all element factories are returned.
+func ElementFactories(klass string) []*ElementFactory {
+	list := C.listElementFactories()
+	defer C.gst_plugin_feature_list_free(list)
+
+	var ret []*ElementFactory
+	for l := list; l != nil; l = l.next {
+		f := newElementFactoryRef(unsafe.Pointer(l.data))
+		if matchKlass(f.Klass(), klass) {
+			ret = append(ret, f)
+		}
+	}
+	return ret
+}
+
+// ElementFactoriesForCaps returns element factories which have a pad template
+// of the direction compatible with the caps sorted by the rank.
+// If subsetOnly is true, the pad template caps must be a superset of the caps.
+func ElementFactoriesForCaps(caps string, direction PadDirection, subsetOnly bool) ([]*ElementFactory, error) {
+	cCaps := C.CString(caps)
+	defer C.free(unsafe.Pointer(cCaps))
+	var ok C.gboolean
+	list := C.filterElementFactoriesByCaps(cCaps, C.GstPadDirection(direction), gboolean(subsetOnly), &ok)
+	if ok == 0 {
+		return nil, fmt.Errorf("Failed to parse caps %s", caps)
+	}
+	defer C.gst_plugin_feature_list_free(list)
+
+	var ret []*ElementFactory
+	for l := list; l != nil; l = l.next {
+		ret = append(ret, newElementFactoryRef(unsafe.Pointer(l.data)))
+	}
+	return ret, nil
+}
+
+func matchKlass(klass, query string) bool {
+	components := make(map[string]bool)
+	for _, c := range strings.Split(klass, "/") {
+		components[c] = true
+	}
+	for _, c := range strings.Split(query, "/") {
+		if c != "" && !components[c] {
+			return false
+		}
+	}
+	return true
+}
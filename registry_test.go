@@ -0,0 +1,115 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gst
+
+import (
+	"testing"
+)
+
+func TestFindPlugin(t *testing.T) {
+	p, err := FindPlugin("coreelements")
+	if err != nil {
+		t.Fatalf("Failed to find plugin: %v", err)
+	}
+	if p.Version == "" {
+		t.Error("Plugin version must not be empty")
+	}
+	if p.Source != "gstreamer" {
+		t.Errorf("Source of coreelements must be \"gstreamer\", but got %s", p.Source)
+	}
+	if p.Blacklisted {
+		t.Error("coreelements must not be blacklisted")
+	}
+
+	var found bool
+	for _, f := range p.Features() {
+		if f.Name == "queue" {
+			found = true
+			if f.Type != "GstElementFactory" {
+				t.Errorf("Unexpected feature type %s", f.Type)
+			}
+			if f.Plugin != "coreelements" {
+				t.Errorf("Unexpected plugin name %s", f.Plugin)
+			}
+		}
+	}
+	if !found {
+		t.Error("coreelements must provide queue")
+	}
+
+	if _, err := FindPlugin("inexistent-plugin"); err == nil {
+		t.Error("FindPlugin with inexistent plugin must fail")
+	}
+}
+
+func TestPlugins(t *testing.T) {
+	var found bool
+	for _, p := range Plugins() {
+		if p.Name == "coreelements" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("Plugins must contain coreelements")
+	}
+}
+
+func TestElementFactories(t *testing.T) {
+	names := make(map[string]bool)
+	for _, f := range ElementFactories("Sink") {
+		names[f.Name()] = true
+	}
+	if !names["fakesink"] {
+		t.Error("Sink factories must contain fakesink")
+	}
+	if names["fakesrc"] {
+		t.Error("Sink factories must not contain fakesrc")
+	}
+}
+
+func TestElementFactoriesForCaps(t *testing.T) {
+	fs, err := ElementFactoriesForCaps("audio/x-raw", PadSink, false)
+	if err != nil {
+		t.Fatalf("Failed to find factories: %v", err)
+	}
+	names := make(map[string]bool)
+	for _, f := range fs {
+		names[f.Name()] = true
+	}
+	if !names["audioconvert"] {
+		t.Error("audio/x-raw sink factories must contain audioconvert")
+	}
+
+	if _, err := ElementFactoriesForCaps("invalid caps,,", PadSink, false); err == nil {
+		t.Error("ElementFactoriesForCaps with invalid caps must fail")
+	}
+}
+
+func TestMatchKlass(t *testing.T) {
+	testCases := []struct {
+		klass, query string
+		expected     bool
+	}{
+		{"Codec/Encoder/Video", "Codec/Encoder/Video", true},
+		{"Codec/Encoder/Video/Hardware", "Encoder/Video", true},
+		{"Codec/Decoder/Video", "Encoder/Video", false},
+		{"Sink", "", true},
+	}
+	for _, tt := range testCases {
+		if m := matchKlass(tt.klass, tt.query); m != tt.expected {
+			t.Errorf("matchKlass(%s, %s) must be %v", tt.klass, tt.query, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gst
+
+// #cgo pkg-config: gobject-2.0 gstreamer-1.0
+// #include <gst/gst.h>
+// gboolean seekSimple(void* element, GstFormat format, GstSeekFlags flags, gint64 pos)
+// {
+//   return gst_element_seek_simple(element, format, flags, pos);
+// }
+// gboolean seekFull(void* element, gdouble rate, GstFormat format, GstSeekFlags flags,
+//                   GstSeekType start_type, gint64 start, GstSeekType stop_type, gint64 stop)
+// {
+//   return gst_element_seek(element, rate, format, flags, start_type, start, stop_type, stop);
+// }
+// gboolean sendStepEvent(void* element, GstFormat format, guint64 amount, gdouble rate,
+//                        gboolean flush, gboolean intermediate)
+// {
+//   return gst_element_send_event(element, gst_event_new_step(format, amount, rate, flush, intermediate));
+// }
+import "C"
+
+import (
+	"fmt"
+	"time"
+)
+
+// SeekFlags is a bit mask of the seek options.
+type SeekFlags uint
+
+const (
+	// SeekFlagNone specifies no seek options.
+	SeekFlagNone SeekFlags = C.GST_SEEK_FLAG_NONE
+	// SeekFlagFlush discards the queued data and starts playback from the new position immediately.
+	SeekFlagFlush SeekFlags = C.GST_SEEK_FLAG_FLUSH
+	// SeekFlagAccurate seeks to the exact position even if it is slow.
+	SeekFlagAccurate SeekFlags = C.GST_SEEK_FLAG_ACCURATE
+	// SeekFlagKeyUnit seeks to the nearest key unit.
+	SeekFlagKeyUnit SeekFlags = C.GST_SEEK_FLAG_KEY_UNIT
+	// SeekFlagSegment posts a segment-done message instead of EOS at the end of the segment.
+	SeekFlagSegment SeekFlags = C.GST_SEEK_FLAG_SEGMENT
+	// SeekFlagTrickMode enables trick mode playback.
+	SeekFlagTrickMode SeekFlags = C.GST_SEEK_FLAG_TRICKMODE
+	// SeekFlagSnapBefore seeks to the key unit before the position.
+	SeekFlagSnapBefore SeekFlags = C.GST_SEEK_FLAG_SNAP_BEFORE
+	// SeekFlagSnapAfter seeks to the key unit after the position.
+	SeekFlagSnapAfter SeekFlags = C.GST_SEEK_FLAG_SNAP_AFTER
+	// SeekFlagSnapNearest seeks to the key unit nearest to the position.
+	SeekFlagSnapNearest SeekFlags = C.GST_SEEK_FLAG_SNAP_NEAREST
+	// SeekFlagTrickModeKeyUnits decodes only key units in trick mode.
+	SeekFlagTrickModeKeyUnits SeekFlags = C.GST_SEEK_FLAG_TRICKMODE_KEY_UNITS
+	// SeekFlagTrickModeNoAudio drops audio in trick mode.
+	SeekFlagTrickModeNoAudio SeekFlags = C.GST_SEEK_FLAG_TRICKMODE_NO_AUDIO
+)
+
+// SeekType specifies how the start and the stop positions are interpreted.
+type SeekType int
+
+const (
+	// SeekTypeNone keeps the current position.
+	SeekTypeNone SeekType = C.GST_SEEK_TYPE_NONE
+	// SeekTypeSet sets the absolute position.
+	SeekTypeSet SeekType = C.GST_SEEK_TYPE_SET
+	// SeekTypeEnd sets the position relative to the end of the stream.
+	SeekTypeEnd SeekType = C.GST_SEEK_TYPE_END
+)
+
+// Seek seeks to the position.
+// The element must be in StatePaused or StatePlaying.
+func (s *Element) Seek(position time.Duration, flags SeekFlags) error {
+	if C.seekSimple(s.p, C.GST_FORMAT_TIME, C.GstSeekFlags(flags), C.gint64(position)) == 0 {
+		return fmt.Errorf("Seek to %v failed", position)
+	}
+	return nil
+}
+
+// SeekFull seeks with the playback rate and the start and stop positions in the format.
+// Negative rate plays backward.
+// Pass SeekTypeNone and -1 to keep the stop position unchanged.
+func (s *Element) SeekFull(rate float64, format Format, flags SeekFlags, startType SeekType, start int64, stopType SeekType, stop int64) error {
+	if rate == 0 {
+		return fmt.Errorf("Seek rate must not be zero")
+	}
+	if C.seekFull(
+		s.p, C.gdouble(rate), C.GstFormat(format), C.GstSeekFlags(flags),
+		C.GstSeekType(startType), C.gint64(start), C.GstSeekType(stopType), C.gint64(stop),
+	) == 0 {
+		return fmt.Errorf("Seek failed")
+	}
+	return nil
+}
+
+// Step steps the playback by the amount in the format.
+// FormatBuffers steps by frames for video.
+// If flush is true, the previous step operation is canceled.
+// If intermediate is true, the step is a part of the series of steps.
+func (s *Element) Step(format Format, amount uint64, rate float64, flush, intermediate bool) error {
+	if rate <= 0 {
+		return fmt.Errorf("Step rate must be positive")
+	}
+	if C.sendStepEvent(s.p, C.GstFormat(format), C.guint64(amount), C.gdouble(rate), gboolean(flush), gboolean(intermediate)) == 0 {
+		return fmt.Errorf("Step event is not handled")
+	}
+	return nil
+}
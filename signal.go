@@ -0,0 +1,269 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gst
+
+// #cgo pkg-config: gobject-2.0 gstreamer-1.0
+// #include "closure.h"
+// #include "gvalue.h"
+import "C"
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+)
+
+// closureFunc is called on the signal emission with the return value
+// and the parameters including the instance.
+type closureFunc func(ret *C.GValue, params []C.GValue)
+
+var (
+	closures     = make(map[int32]closureFunc)
+	closureMutex sync.RWMutex
+	closureIDCnt = int32(0)
+)
+
+// SignalHandler is a handle of the callback connected to the signal.
+type SignalHandler struct {
+	element *Element
+	id      C.gulong
+	once    sync.Once
+}
+
+// Disconnect disconnects the callback from the signal.
+// It is safe to call Disconnect multiple times.
+func (h *SignalHandler) Disconnect() {
+	h.once.Do(func() {
+		C.disconnectClosure(h.element.UnsafePointer(), h.id)
+		h.element = nil
+	})
+}
+
+func (s *Element) connectClosure(signal string, f closureFunc) (*SignalHandler, error) {
+	id := atomic.AddInt32(&closureIDCnt, 1)
+	closureMutex.Lock()
+	closures[id] = f
+	closureMutex.Unlock()
+
+	cSignal := C.CString(signal)
+	defer C.free(unsafe.Pointer(cSignal))
+	hid := C.connectClosure(s.UnsafePointer(), cSignal, C.int(id), C.FALSE)
+	if hid == 0 {
+		closureMutex.Lock()
+		delete(closures, id)
+		closureMutex.Unlock()
+		return nil, fmt.Errorf("Signal %s not found", signal)
+	}
+	return &SignalHandler{element: s, id: hid}, nil
+}
+
+// Connect registers a callback called on the signal emission.
+// f must be a function which takes *Element followed by the signal arguments.
+// If the signal has a return value, f must return a value of it.
+// Arguments are converted in the same way as Element.GetProperty and passed as
+// the parameter type of f if it is assignable or convertible.
+// Pointers to the boxed values are valid only during the callback.
+func (s *Element) Connect(signal string, f interface{}) (*SignalHandler, error) {
+	q, _, err := s.querySignal(signal)
+	if err != nil {
+		return nil, err
+	}
+	fv := reflect.ValueOf(f)
+	ft := fv.Type()
+	if ft.Kind() != reflect.Func {
+		return nil, fmt.Errorf("Callback must be a function, but got %T", f)
+	}
+	nParams := int(q.n_params)
+	if ft.NumIn() != nParams+1 || ft.In(0) != reflect.TypeOf(s) {
+		return nil, fmt.Errorf("Callback of %s must take *Element and %d arguments", signal, nParams)
+	}
+	for i := 1; i <= nParams; i++ {
+		types := goValueTypes(C.signalParamType(q, C.guint(i-1)))
+		if types == nil {
+			continue
+		}
+		ok := false
+		for _, t := range types {
+			if canPassAs(t, ft.In(i)) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return nil, fmt.Errorf("Argument %d of %s can not be passed as %s, expected %s", i, signal, ft.In(i), types[0])
+		}
+	}
+	hasRet := C.signalReturnType(q) != C.G_TYPE_NONE
+	if hasRet && ft.NumOut() != 1 || !hasRet && ft.NumOut() != 0 {
+		return nil, fmt.Errorf("Callback of %s has wrong number of return values", signal)
+	}
+
+	return s.connectClosure(signal, func(ret *C.GValue, params []C.GValue) {
+		args := make([]reflect.Value, len(params))
+		args[0] = reflect.ValueOf(newElementRef(unsafe.Pointer(C.g_value_get_object(&params[0]))))
+		for i := 1; i < len(params); i++ {
+			args[i] = reflect.Zero(ft.In(i))
+			val, err := goValue(&params[i])
+			if err != nil {
+				log.Printf("Failed to convert argument %d of %s: %v", i, signal, err)
+				continue
+			}
+			if val == nil {
+				continue
+			}
+			rv := reflect.ValueOf(val)
+			switch {
+			case rv.Type().AssignableTo(ft.In(i)):
+				args[i] = rv
+			case canPassAs(rv.Type(), ft.In(i)):
+				args[i] = rv.Convert(ft.In(i))
+			default:
+				log.Printf("Argument %d of %s (%T) can not be passed as %s", i, signal, val, ft.In(i))
+			}
+		}
+		out := fv.Call(args)
+		if hasRet && ret != nil {
+			if err := setGValue(ret, out[0].Interface()); err != nil {
+				log.Printf("Failed to set return value of %s: %v", signal, err)
+			}
+		}
+	})
+}
+
+// Emit emits the action signal and returns the return value of the signal.
+// Arguments and the return value are converted in the same way as
+// Element.SetProperty and Element.GetProperty.
+// Boxed return value of unsupported type is returned as unsafe.Pointer
+// owned by the caller.
+func (s *Element) Emit(signal string, args ...interface{}) (interface{}, error) {
+	q, detail, err := s.querySignal(signal)
+	if err != nil {
+		return nil, err
+	}
+	if q.signal_flags&C.G_SIGNAL_ACTION == 0 {
+		return nil, fmt.Errorf("Signal %s is not an action signal", signal)
+	}
+	n := q.n_params
+	if len(args) != int(n) {
+		return nil, fmt.Errorf("Signal %s takes %d arguments, but got %d", signal, int(n), len(args))
+	}
+
+	params := C.newGValues(n + 1)
+	defer C.freeGValues(params, n+1)
+	C.g_value_init(C.gValueAt(params, 0), C.getObjectType(s.UnsafePointer()))
+	C.g_value_set_object(C.gValueAt(params, 0), C.gpointer(s.UnsafePointer()))
+	for i, arg := range args {
+		v := C.gValueAt(params, C.guint(i+1))
+		C.g_value_init(v, C.signalParamType(q, C.guint(i)))
+		if err := setGValue(v, arg); err != nil {
+			return nil, fmt.Errorf("Argument %d of %s: %v", i, signal, err)
+		}
+	}
+
+	rt := C.signalReturnType(q)
+	if rt == C.G_TYPE_NONE {
+		C.g_signal_emitv(params, q.signal_id, detail, nil)
+		return nil, nil
+	}
+	ret := C.newGValue()
+	defer C.freeGValue(ret)
+	C.g_value_init(ret, rt)
+	C.g_signal_emitv(params, q.signal_id, detail, ret)
+
+	val, err := goValue(ret)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := val.(unsafe.Pointer); ok && C.g_type_fundamental(rt) == C.G_TYPE_BOXED {
+		return unsafe.Pointer(C.g_value_dup_boxed(ret)), nil
+	}
+	return val, nil
+}
+
+func (s *Element) querySignal(signal string) (*C.GSignalQuery, C.GQuark, error) {
+	cSignal := C.CString(signal)
+	defer C.free(unsafe.Pointer(cSignal))
+	var q C.GSignalQuery
+	var detail C.GQuark
+	if C.querySignal(s.UnsafePointer(), cSignal, &q, &detail) == 0 {
+		return nil, 0, fmt.Errorf("Signal %s not found", signal)
+	}
+	return &q, detail, nil
+}
+
+// ConnectNotify registers a callback called when the property is changed.
+// The callback receives the element, the property name and the new value.
+func (s *Element) ConnectNotify(name string, f func(*Element, string, interface{})) (*SignalHandler, error) {
+	if _, err := s.FindProperty(name); err != nil {
+		return nil, err
+	}
+	return s.connectClosure("notify::"+name, func(_ *C.GValue, params []C.GValue) {
+		e := newElementRef(unsafe.Pointer(C.g_value_get_object(&params[0])))
+		val, err := e.GetProperty(name)
+		if err != nil {
+			log.Printf("Failed to get notified property %s: %v", name, err)
+		}
+		f(e, name, val)
+	})
+}
+
+// ConnectDeepNotify registers a callback called when the property of
+// the child element is changed.
+// If name is empty, changes of any property are notified.
+// The callback receives the element, the changed child element, the property name and the new value.
+func (s *Element) ConnectDeepNotify(name string, f func(*Element, *Element, string, interface{})) (*SignalHandler, error) {
+	signal := "deep-notify"
+	if name != "" {
+		signal += "::" + name
+	}
+	return s.connectClosure(signal, func(_ *C.GValue, params []C.GValue) {
+		obj := unsafe.Pointer(C.g_value_get_object(&params[1]))
+		if C.isElement(obj) == 0 {
+			return
+		}
+		pspec := C.g_value_get_param(&params[2])
+		propName := C.GoString(C.g_param_spec_get_name(pspec))
+
+		e := newElementRef(unsafe.Pointer(C.g_value_get_object(&params[0])))
+		child := newElementRef(obj)
+		val, err := child.GetProperty(propName)
+		if err != nil {
+			log.Printf("Failed to get notified property %s: %v", propName, err)
+		}
+		f(e, child, propName, val)
+	})
+}
+
+//export goClosureMarshal
+func goClosureMarshal(id C.int, ret *C.GValue, n C.guint, params *C.GValue) {
+	closureMutex.RLock()
+	f, ok := closures[int32(id)]
+	closureMutex.RUnlock()
+	if !ok {
+		log.Printf("Unhandled signal (id: %d)", int(id))
+		return
+	}
+	f(ret, (*[1 << 16]C.GValue)(unsafe.Pointer(params))[:n:n])
+}
+
+//export goClosureFinalize
+func goClosureFinalize(id C.int) {
+	closureMutex.Lock()
+	delete(closures, int32(id))
+	closureMutex.Unlock()
+}
@@ -0,0 +1,150 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gst
+
+import (
+	"testing"
+	"unsafe"
+
+	"github.com/seqsense/sq-gst-go/internal/dummyelement"
+)
+
+func TestConnectNotify(t *testing.T) {
+	e := NewElement(dummyelement.New())
+
+	var notified []interface{}
+	h, err := e.ConnectNotify("num-buffers", func(e *Element, name string, val interface{}) {
+		if name != "num-buffers" {
+			t.Errorf("Unexpected property name %s", name)
+		}
+		notified = append(notified, val)
+	})
+	if err != nil {
+		t.Fatalf("Failed to ConnectNotify: %v", err)
+	}
+
+	if err := e.SetProperty("num-buffers", 10); err != nil {
+		t.Fatalf("Failed to SetProperty: %v", err)
+	}
+	if len(notified) != 1 || notified[0] != 10 {
+		t.Errorf("Expected notification of 10, got %v", notified)
+	}
+
+	h.Disconnect()
+	h.Disconnect()
+	if err := e.SetProperty("num-buffers", 20); err != nil {
+		t.Fatalf("Failed to SetProperty: %v", err)
+	}
+	if len(notified) != 1 {
+		t.Errorf("Notification must not be called after Disconnect, got %v", notified)
+	}
+}
+
+func TestConnectNotify_NotFound(t *testing.T) {
+	e := NewElement(dummyelement.New())
+	if _, err := e.ConnectNotify("inexistent-property", func(*Element, string, interface{}) {}); err == nil {
+		t.Error("ConnectNotify for inexistent property must fail")
+	}
+}
+
+func TestConnect(t *testing.T) {
+	e := NewElement(dummyelement.New())
+
+	var names []string
+	h, err := e.Connect("notify::sync", func(e *Element, p *ParamSpec) {
+		names = append(names, p.Name)
+	})
+	if err != nil {
+		t.Fatalf("Failed to Connect: %v", err)
+	}
+	defer h.Disconnect()
+
+	if err := e.SetProperty("sync", true); err != nil {
+		t.Fatalf("Failed to SetProperty: %v", err)
+	}
+	if len(names) != 1 || names[0] != "sync" {
+		t.Errorf("Expected notify signal of sync, got %v", names)
+	}
+}
+
+func TestConnect_ArgTypes(t *testing.T) {
+	testCases := map[string]struct {
+		signal string
+		f      interface{}
+	}{
+		"Exact":     {signal: "handoff", f: func(*Element, unsafe.Pointer, *Pad) {}},
+		"Interface": {signal: "handoff", f: func(*Element, interface{}, interface{}) {}},
+	}
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			e := NewElement(dummyelement.New())
+			h, err := e.Connect(tt.signal, tt.f)
+			if err != nil {
+				t.Fatalf("Failed to Connect: %v", err)
+			}
+			h.Disconnect()
+		})
+	}
+}
+
+func TestConnect_Error(t *testing.T) {
+	testCases := map[string]struct {
+		signal string
+		f      interface{}
+	}{
+		"NotFound":      {signal: "inexistent-signal", f: func(*Element) {}},
+		"NotFunction":   {signal: "notify", f: 1},
+		"WrongArgs":     {signal: "notify", f: func(*Element) {}},
+		"WrongInstance": {signal: "notify", f: func(int, *ParamSpec) {}},
+		"WrongReturn":   {signal: "notify", f: func(*Element, *ParamSpec) bool { return false }},
+		"WrongArgType":  {signal: "notify", f: func(*Element, string) {}},
+		"WrongObject":   {signal: "handoff", f: func(*Element, unsafe.Pointer, *Element) {}},
+	}
+	for name, tt := range testCases {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			e := NewElement(dummyelement.New())
+			if _, err := e.Connect(tt.signal, tt.f); err == nil {
+				t.Error("Connect must fail")
+			}
+		})
+	}
+}
+
+func TestEmit(t *testing.T) {
+	e := NewElement(dummyelement.NewWithFactory("playbin"))
+
+	ret, err := e.Emit("get-audio-pad", 0)
+	if err != nil {
+		t.Fatalf("Failed to Emit: %v", err)
+	}
+	if ret != nil {
+		t.Errorf("get-audio-pad of empty playbin must return nil, got %v", ret)
+	}
+
+	if _, err := e.Emit("get-audio-pad"); err == nil {
+		t.Error("Emit with wrong number of arguments must fail")
+	}
+	if _, err := e.Emit("get-audio-pad", "0"); err == nil {
+		t.Error("Emit with wrong type of argument must fail")
+	}
+	if _, err := e.Emit("about-to-finish"); err == nil {
+		t.Error("Emit of non-action signal must fail")
+	}
+	if _, err := e.Emit("inexistent-signal"); err == nil {
+		t.Error("Emit of inexistent signal must fail")
+	}
+}
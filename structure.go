@@ -0,0 +1,160 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gst
+
+// #cgo pkg-config: gobject-2.0 gstreamer-1.0
+// #include <stdlib.h>
+// #include <gst/gst.h>
+// #include "gvalue.h"
+import "C"
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"unsafe"
+)
+
+// Structure is a wrapper of GstStructure which is a named collection of typed fields.
+type Structure struct {
+	p *C.GstStructure
+}
+
+// NewStructure creates a new empty structure.
+// The name must start with a letter and consist of letters, digits and "/-_.:+".
+func NewStructure(name string) (*Structure, error) {
+	if !validStructureName(name) {
+		return nil, fmt.Errorf("Invalid structure name %s", name)
+	}
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+	return newStructure(C.gst_structure_new_empty(cName)), nil
+}
+
+// ParseStructure creates a structure from the string representation
+// like "name, field=(int)1".
+func ParseStructure(str string) (*Structure, error) {
+	cStr := C.CString(str)
+	defer C.free(unsafe.Pointer(cStr))
+	p := C.gst_structure_from_string(cStr, nil)
+	if p == nil {
+		return nil, fmt.Errorf("Failed to parse structure %s", str)
+	}
+	return newStructure(p), nil
+}
+
+func validStructureName(name string) bool {
+	for i, c := range name {
+		switch {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z':
+		case i == 0:
+			return false
+		case '0' <= c && c <= '9', strings.ContainsRune("/-_.:+", c):
+		default:
+			return false
+		}
+	}
+	return name != ""
+}
+
+// NewStructureFromPointer creates a new GstStructure wrapper from given raw pointer.
+// The wrapper takes the ownership of the structure.
+func NewStructureFromPointer(p unsafe.Pointer) *Structure {
+	return newStructure((*C.GstStructure)(p))
+}
+
+// newStructure creates a structure wrapper which takes the ownership.
+func newStructure(p *C.GstStructure) *Structure {
+	s := &Structure{p: p}
+	runtime.SetFinalizer(s, finalizeStructure)
+	return s
+}
+
+func finalizeStructure(s *Structure) {
+	C.gst_structure_free(s.p)
+}
+
+// UnsafePointer returns the raw pointer of the structure.
+func (s *Structure) UnsafePointer() unsafe.Pointer {
+	return unsafe.Pointer(s.p)
+}
+
+// Name returns the name of the structure.
+func (s *Structure) Name() string {
+	return C.GoString(C.gst_structure_get_name(s.p))
+}
+
+// String returns the string representation of the structure.
+func (s *Structure) String() string {
+	str := C.gst_structure_to_string(s.p)
+	defer C.g_free(C.gpointer(str))
+	return C.GoString(str)
+}
+
+// Has returns true if the structure has the field.
+func (s *Structure) Has(field string) bool {
+	cField := C.CString(field)
+	defer C.free(unsafe.Pointer(cField))
+	return C.gst_structure_has_field(s.p, cField) != 0
+}
+
+// Fields returns the names of the fields.
+func (s *Structure) Fields() []string {
+	n := int(C.gst_structure_n_fields(s.p))
+	ret := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		ret = append(ret, C.GoString(C.gst_structure_nth_field_name(s.p, C.guint(i))))
+	}
+	return ret
+}
+
+// Get returns the value of the field.
+// Values are converted in the same way as Element.GetProperty.
+func (s *Structure) Get(field string) (interface{}, error) {
+	cField := C.CString(field)
+	defer C.free(unsafe.Pointer(cField))
+	v := C.gst_structure_get_value(s.p, cField)
+	if v == nil {
+		return nil, fmt.Errorf("Field %s not found", field)
+	}
+	return goValue(v)
+}
+
+// Set sets the value of the field.
+// The type of the field is determined by the Go type:
+// int and uint are stored as 32-bit integers, and int64 and uint64 as 64-bit integers.
+func (s *Structure) Set(field string, val interface{}) error {
+	v := C.newGValue()
+	defer C.freeGValue(v)
+	if err := initGValue(v, val); err != nil {
+		return err
+	}
+	cField := C.CString(field)
+	defer C.free(unsafe.Pointer(cField))
+	C.gst_structure_set_value(s.p, cField, v)
+	return nil
+}
+
+// Remove removes the field.
+func (s *Structure) Remove(field string) {
+	cField := C.CString(field)
+	defer C.free(unsafe.Pointer(cField))
+	C.gst_structure_remove_field(s.p, cField)
+}
+
+// Copy returns a deep copy of the structure.
+func (s *Structure) Copy() *Structure {
+	return newStructure(C.gst_structure_copy(s.p))
+}
@@ -0,0 +1,88 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gst
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStructure(t *testing.T) {
+	s, err := NewStructure("test-struct")
+	if err != nil {
+		t.Fatalf("Failed to create structure: %v", err)
+	}
+	values := map[string]interface{}{
+		"bool":   true,
+		"int":    -1,
+		"uint":   uint(2),
+		"int64":  int64(-3),
+		"uint64": uint64(4),
+		"double": 0.5,
+		"string": "foo",
+	}
+	for k, v := range values {
+		if err := s.Set(k, v); err != nil {
+			t.Errorf("Failed to set %s: %v", k, err)
+		}
+	}
+	if err := s.Set("invalid", struct{}{}); err == nil {
+		t.Error("Setting unsupported type must fail")
+	}
+	if n := len(s.Fields()); n != len(values) {
+		t.Errorf("Structure must have %d fields, but got %d", len(values), n)
+	}
+
+	parsed, err := ParseStructure(s.String())
+	if err != nil {
+		t.Fatalf("Failed to parse structure %s: %v", s, err)
+	}
+	if n := parsed.Name(); n != "test-struct" {
+		t.Errorf("Expected name \"test-struct\", got %s", n)
+	}
+	for k, v := range values {
+		got, err := parsed.Get(k)
+		if err != nil {
+			t.Errorf("Failed to get %s: %v", k, err)
+			continue
+		}
+		if !reflect.DeepEqual(v, got) {
+			t.Errorf("Expected %s=%v (%T), got %v (%T)", k, v, v, got, got)
+		}
+	}
+
+	parsed.Remove("bool")
+	if parsed.Has("bool") {
+		t.Error("Removed field must not exist")
+	}
+	if _, err := parsed.Get("bool"); err == nil {
+		t.Error("Getting removed field must fail")
+	}
+	if !s.Has("bool") {
+		t.Error("Original structure must not be changed")
+	}
+}
+
+func TestStructure_invalid(t *testing.T) {
+	if _, err := NewStructure("1abc"); err == nil {
+		t.Error("Structure name starting with digit must be invalid")
+	}
+	if _, err := NewStructure(""); err == nil {
+		t.Error("Empty structure name must be invalid")
+	}
+	if _, err := ParseStructure("test, a=(int)"); err == nil {
+		t.Error("Parsing invalid structure must fail")
+	}
+}
@@ -0,0 +1,315 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gst
+
+// #cgo pkg-config: gobject-2.0 gstreamer-1.0
+// #include <stdlib.h>
+// #include <gst/gst.h>
+// #include "gvalue.h"
+// void refTagList(GstTagList* tags)
+// {
+//   gst_mini_object_ref(GST_MINI_OBJECT_CAST(tags));
+// }
+// void unrefTagList(GstTagList* tags)
+// {
+//   gst_mini_object_unref(GST_MINI_OBJECT_CAST(tags));
+// }
+// GstTagList* makeTagListWritable(GstTagList* tags)
+// {
+//   return GST_TAG_LIST_CAST(gst_mini_object_make_writable(GST_MINI_OBJECT_CAST(tags)));
+// }
+// gboolean isTagSetter(void* element)
+// {
+//   return GST_IS_TAG_SETTER(element);
+// }
+// void mergeTags(void* element, GstTagList* tags, GstTagMergeMode mode)
+// {
+//   gst_tag_setter_merge_tags(GST_TAG_SETTER(element), tags, mode);
+// }
+import "C"
+
+import (
+	"fmt"
+	"runtime"
+	"time"
+	"unsafe"
+)
+
+// Standard tag names.
+const (
+	TagTitle                = "title"
+	TagArtist               = "artist"
+	TagAlbum                = "album"
+	TagComment              = "comment"
+	TagDescription          = "description"
+	TagDate                 = "date"
+	TagDateTime             = "datetime"
+	TagLocation             = "location"
+	TagLanguageCode         = "language-code"
+	TagEncoder              = "encoder"
+	TagApplicationName      = "application-name"
+	TagContainerFormat      = "container-format"
+	TagCodec                = "codec"
+	TagVideoCodec           = "video-codec"
+	TagAudioCodec           = "audio-codec"
+	TagBitrate              = "bitrate"
+	TagNominalBitrate       = "nominal-bitrate"
+	TagDuration             = "duration"
+	TagGeoLocationName      = "geo-location-name"
+	TagGeoLocationLatitude  = "geo-location-latitude"
+	TagGeoLocationLongitude = "geo-location-longitude"
+	TagGeoLocationElevation = "geo-location-elevation"
+	TagDeviceManufacturer   = "device-manufacturer"
+	TagDeviceModel          = "device-model"
+)
+
+// TagMergeMode specifies how the tags are merged to the existing tags.
+type TagMergeMode int
+
+const (
+	// TagMergeReplaceAll replaces all existing tags.
+	TagMergeReplaceAll TagMergeMode = C.GST_TAG_MERGE_REPLACE_ALL
+	// TagMergeReplace replaces the existing values of the same tags.
+	TagMergeReplace TagMergeMode = C.GST_TAG_MERGE_REPLACE
+	// TagMergeAppend appends the values after the existing values.
+	TagMergeAppend TagMergeMode = C.GST_TAG_MERGE_APPEND
+	// TagMergePrepend prepends the values before the existing values.
+	TagMergePrepend TagMergeMode = C.GST_TAG_MERGE_PREPEND
+	// TagMergeKeep keeps the existing values of the same tags.
+	TagMergeKeep TagMergeMode = C.GST_TAG_MERGE_KEEP
+	// TagMergeKeepAll keeps all existing tags and ignores the new tags.
+	TagMergeKeepAll TagMergeMode = C.GST_TAG_MERGE_KEEP_ALL
+)
+
+// TagList is a wrapper of GstTagList.
+// Modifications make the tag list writable by copying it
+// if it is shared with others.
+type TagList struct {
+	p *C.GstTagList
+}
+
+// NewTagList creates a new empty tag list.
+func NewTagList() *TagList {
+	return newTagList(C.gst_tag_list_new_empty())
+}
+
+// ParseTagList creates a tag list from the string representation
+// like `taglist, title=(string)foo`.
+func ParseTagList(str string) (*TagList, error) {
+	cStr := C.CString(str)
+	defer C.free(unsafe.Pointer(cStr))
+	p := C.gst_tag_list_new_from_string(cStr)
+	if p == nil {
+		return nil, fmt.Errorf("Failed to parse tag list %s", str)
+	}
+	return newTagList(p), nil
+}
+
+// NewTagListFromPointer creates a new GstTagList wrapper from given raw pointer.
+// The wrapper takes the ownership of the reference.
+func NewTagListFromPointer(p unsafe.Pointer) *TagList {
+	return newTagList((*C.GstTagList)(p))
+}
+
+func newTagList(p *C.GstTagList) *TagList {
+	l := &TagList{p: p}
+	runtime.SetFinalizer(l, finalizeTagList)
+	return l
+}
+
+func finalizeTagList(l *TagList) {
+	C.unrefTagList(l.p)
+}
+
+// UnsafePointer returns the raw pointer of the tag list.
+func (l *TagList) UnsafePointer() unsafe.Pointer {
+	return unsafe.Pointer(l.p)
+}
+
+// String returns the string representation of the tag list.
+func (l *TagList) String() string {
+	str := C.gst_tag_list_to_string(l.p)
+	defer C.g_free(C.gpointer(str))
+	return C.GoString(str)
+}
+
+// IsEmpty returns true if the tag list has no tags.
+func (l *TagList) IsEmpty() bool {
+	return C.gst_tag_list_is_empty(l.p) != 0
+}
+
+// Tags returns the names of the tags in the list.
+func (l *TagList) Tags() []string {
+	n := int(C.gst_tag_list_n_tags(l.p))
+	ret := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		ret = append(ret, C.GoString(C.gst_tag_list_nth_tag_name(l.p, C.guint(i))))
+	}
+	return ret
+}
+
+// Size returns the number of the values of the tag.
+func (l *TagList) Size(tag string) int {
+	cTag := C.CString(tag)
+	defer C.free(unsafe.Pointer(cTag))
+	return int(C.gst_tag_list_get_tag_size(l.p, cTag))
+}
+
+// Get returns the i-th value of the tag.
+// Values are converted in the same way as Element.GetProperty.
+func (l *TagList) Get(tag string, i int) (interface{}, error) {
+	cTag := C.CString(tag)
+	defer C.free(unsafe.Pointer(cTag))
+	if i < 0 || int(C.gst_tag_list_get_tag_size(l.p, cTag)) <= i {
+		return nil, fmt.Errorf("Tag %s[%d] not found", tag, i)
+	}
+	return goValue(C.gst_tag_list_get_value_index(l.p, cTag, C.guint(i)))
+}
+
+// GetString returns the first value of the string tag.
+func (l *TagList) GetString(tag string) (string, bool) {
+	v, err := l.Get(tag, 0)
+	s, ok := v.(string)
+	return s, err == nil && ok
+}
+
+// GetUint returns the first value of the unsigned integer tag like TagBitrate.
+func (l *TagList) GetUint(tag string) (uint, bool) {
+	v, err := l.Get(tag, 0)
+	u, ok := v.(uint)
+	return u, err == nil && ok
+}
+
+// GetUint64 returns the first value of the 64-bit unsigned integer tag like TagDuration.
+func (l *TagList) GetUint64(tag string) (uint64, bool) {
+	v, err := l.Get(tag, 0)
+	u, ok := v.(uint64)
+	return u, err == nil && ok
+}
+
+// GetDouble returns the first value of the floating point tag like TagGeoLocationLatitude.
+func (l *TagList) GetDouble(tag string) (float64, bool) {
+	v, err := l.Get(tag, 0)
+	f, ok := v.(float64)
+	return f, err == nil && ok
+}
+
+// GetTime returns the first value of the date tag like TagDate and TagDateTime.
+func (l *TagList) GetTime(tag string) (time.Time, bool) {
+	v, err := l.Get(tag, 0)
+	t, ok := v.(time.Time)
+	return t, err == nil && ok
+}
+
+// Add adds the value of the tag.
+// The value is converted to the registered type of the tag in the same way as
+// Element.SetProperty. Date tags accept time.Time.
+func (l *TagList) Add(mode TagMergeMode, tag string, val interface{}) error {
+	cTag := C.CString(tag)
+	defer C.free(unsafe.Pointer(cTag))
+	if C.gst_tag_exists(cTag) == 0 {
+		return fmt.Errorf("Tag %s is not registered", tag)
+	}
+	v := C.newGValue()
+	defer C.freeGValue(v)
+	C.g_value_init(v, C.gst_tag_get_type(cTag))
+	if err := setGValue(v, val); err != nil {
+		return err
+	}
+	l.p = C.makeTagListWritable(l.p)
+	C.gst_tag_list_add_value(l.p, C.GstTagMergeMode(mode), cTag, v)
+	return nil
+}
+
+// AddString adds the value of the string tag like TagTitle.
+func (l *TagList) AddString(mode TagMergeMode, tag string, val string) error {
+	return l.addTyped(mode, tag, val, C.G_TYPE_STRING)
+}
+
+// AddUint adds the value of the unsigned integer tag like TagBitrate.
+func (l *TagList) AddUint(mode TagMergeMode, tag string, val uint) error {
+	return l.addTyped(mode, tag, val, C.G_TYPE_UINT)
+}
+
+// AddUint64 adds the value of the 64-bit unsigned integer tag like TagDuration.
+func (l *TagList) AddUint64(mode TagMergeMode, tag string, val uint64) error {
+	return l.addTyped(mode, tag, val, C.G_TYPE_UINT64)
+}
+
+// AddDouble adds the value of the floating point tag like TagGeoLocationLatitude.
+func (l *TagList) AddDouble(mode TagMergeMode, tag string, val float64) error {
+	return l.addTyped(mode, tag, val, C.G_TYPE_DOUBLE)
+}
+
+// AddTime adds the value of the date tag like TagDate and TagDateTime.
+// Only the date part is stored to TagDate.
+func (l *TagList) AddTime(mode TagMergeMode, tag string, val time.Time) error {
+	return l.addTyped(mode, tag, val, C.gDateType(), C.gstDateTimeType())
+}
+
+// addTyped adds the value if the registered type of the tag is one of the types.
+func (l *TagList) addTyped(mode TagMergeMode, tag string, val interface{}, types ...C.GType) error {
+	cTag := C.CString(tag)
+	defer C.free(unsafe.Pointer(cTag))
+	if C.gst_tag_exists(cTag) == 0 {
+		return fmt.Errorf("Tag %s is not registered", tag)
+	}
+	t := C.gst_tag_get_type(cTag)
+	for _, typ := range types {
+		if t == typ {
+			return l.Add(mode, tag, val)
+		}
+	}
+	return fmt.Errorf("Tag %s has type %s", tag, typeName(t))
+}
+
+// Remove removes all values of the tag.
+func (l *TagList) Remove(tag string) {
+	cTag := C.CString(tag)
+	defer C.free(unsafe.Pointer(cTag))
+	l.p = C.makeTagListWritable(l.p)
+	C.gst_tag_list_remove_tag(l.p, cTag)
+}
+
+// Merge returns a new tag list merging the other tag list in the mode.
+// A copy of the tag list is returned if other is nil.
+func (l *TagList) Merge(other *TagList, mode TagMergeMode) *TagList {
+	var p *C.GstTagList
+	if other != nil {
+		p = other.p
+	}
+	return newTagList(C.gst_tag_list_merge(l.p, p, C.GstTagMergeMode(mode)))
+}
+
+// Copy returns a copy of the tag list.
+func (l *TagList) Copy() *TagList {
+	return newTagList(C.gst_tag_list_copy(l.p))
+}
+
+// MergeTags merges the tags to the element implementing GstTagSetter
+// like muxers and encoders.
+// The tags are written to the output stream in addition to the tags from upstream.
+func (s *Element) MergeTags(tags *TagList, mode TagMergeMode) error {
+	if C.isTagSetter(s.p) == 0 {
+		return fmt.Errorf("Element %s is not a tag setter", s.Name())
+	}
+	C.mergeTags(s.p, tags.p, C.GstTagMergeMode(mode))
+	return nil
+}
+
+// NewTagEvent creates a new tag event carrying a copy of the tag list.
+func NewTagEvent(tags *TagList) *Event {
+	return newEvent(C.gst_event_new_tag(C.gst_tag_list_copy(tags.p)))
+}
@@ -0,0 +1,185 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gst
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTagList(t *testing.T) {
+	l := NewTagList()
+	if !l.IsEmpty() {
+		t.Error("New tag list must be empty")
+	}
+	dt := time.Date(2026, time.October, 18, 12, 34, 56, 0, time.FixedZone("", 9*3600))
+	for tag, val := range map[string]interface{}{
+		TagTitle:               "test title",
+		TagDateTime:            dt,
+		TagDate:                dt,
+		TagGeoLocationLatitude: 35.5,
+		TagBitrate:             128000,
+		TagDeviceModel:         "device-001",
+	} {
+		if err := l.Add(TagMergeReplace, tag, val); err != nil {
+			t.Errorf("Failed to add %s: %v", tag, err)
+		}
+	}
+	if err := l.Add(TagMergeReplace, "not-registered-tag", "a"); err == nil {
+		t.Error("Adding unregistered tag must fail")
+	}
+	if err := l.Add(TagMergeReplace, TagTitle, 1); err == nil {
+		t.Error("Adding value of wrong type must fail")
+	}
+	if n := len(l.Tags()); n != 6 {
+		t.Errorf("Tag list must have 6 tags, but got %d", n)
+	}
+
+	if s, ok := l.GetString(TagTitle); !ok || s != "test title" {
+		t.Errorf("Unexpected title %s", s)
+	}
+	if d, ok := l.GetTime(TagDateTime); !ok || !d.Equal(dt) {
+		t.Errorf("Expected datetime %v, got %v", dt, d)
+	}
+	if d, ok := l.GetTime(TagDate); !ok || d.Year() != 2026 || d.Month() != time.October || d.Day() != 18 {
+		t.Errorf("Unexpected date %v", d)
+	}
+	if f, ok := l.GetDouble(TagGeoLocationLatitude); !ok || f != 35.5 {
+		t.Errorf("Unexpected latitude %v", f)
+	}
+	if u, ok := l.GetUint(TagBitrate); !ok || u != 128000 {
+		t.Errorf("Unexpected bitrate %v", u)
+	}
+	if _, ok := l.GetString(TagBitrate); ok {
+		t.Error("Getting bitrate as string must fail")
+	}
+
+	c := l.Copy()
+	if err := c.Add(TagMergeAppend, TagTitle, "second title"); err != nil {
+		t.Fatalf("Failed to append title: %v", err)
+	}
+	if n := c.Size(TagTitle); n != 2 {
+		t.Errorf("Appended title must have 2 values, but got %d", n)
+	}
+	if n := l.Size(TagTitle); n != 1 {
+		t.Errorf("Original tag list must not be changed, but got %d values", n)
+	}
+	c.Remove(TagTitle)
+	if _, ok := c.GetString(TagTitle); ok {
+		t.Error("Removed tag must not exist")
+	}
+
+	parsed, err := ParseTagList(l.String())
+	if err != nil {
+		t.Fatalf("Failed to parse tag list: %v", err)
+	}
+	if s, ok := parsed.GetString(TagDeviceModel); !ok || s != "device-001" {
+		t.Errorf("Unexpected device model %s", s)
+	}
+
+	m := c.Merge(l, TagMergeKeep)
+	if s, ok := m.GetString(TagTitle); !ok || s != "test title" {
+		t.Errorf("Unexpected merged title %s", s)
+	}
+}
+
+func TestTagList_typedSetters(t *testing.T) {
+	l := NewTagList()
+	dt := time.Date(2026, time.October, 18, 12, 34, 56, 0, time.UTC)
+	if err := l.AddString(TagMergeReplace, TagTitle, "test title"); err != nil {
+		t.Errorf("Failed to add title: %v", err)
+	}
+	if err := l.AddUint(TagMergeReplace, TagBitrate, 128000); err != nil {
+		t.Errorf("Failed to add bitrate: %v", err)
+	}
+	if err := l.AddUint64(TagMergeReplace, TagDuration, uint64(time.Second)); err != nil {
+		t.Errorf("Failed to add duration: %v", err)
+	}
+	if err := l.AddDouble(TagMergeReplace, TagGeoLocationLatitude, 35.5); err != nil {
+		t.Errorf("Failed to add latitude: %v", err)
+	}
+	if err := l.AddTime(TagMergeReplace, TagDateTime, dt); err != nil {
+		t.Errorf("Failed to add datetime: %v", err)
+	}
+	if err := l.AddTime(TagMergeReplace, TagDate, dt); err != nil {
+		t.Errorf("Failed to add date: %v", err)
+	}
+
+	if s, ok := l.GetString(TagTitle); !ok || s != "test title" {
+		t.Errorf("Unexpected title %s", s)
+	}
+	if u, ok := l.GetUint(TagBitrate); !ok || u != 128000 {
+		t.Errorf("Unexpected bitrate %v", u)
+	}
+	if u, ok := l.GetUint64(TagDuration); !ok || u != uint64(time.Second) {
+		t.Errorf("Unexpected duration %v", u)
+	}
+	if f, ok := l.GetDouble(TagGeoLocationLatitude); !ok || f != 35.5 {
+		t.Errorf("Unexpected latitude %v", f)
+	}
+	if d, ok := l.GetTime(TagDateTime); !ok || !d.Equal(dt) {
+		t.Errorf("Expected datetime %v, got %v", dt, d)
+	}
+	if d, ok := l.GetTime(TagDate); !ok || d.Year() != 2026 || d.Month() != time.October || d.Day() != 18 {
+		t.Errorf("Unexpected date %v", d)
+	}
+
+	if err := l.AddString(TagMergeReplace, TagBitrate, "128000"); err == nil {
+		t.Error("Adding string to unsigned integer tag must fail")
+	}
+	if err := l.AddUint(TagMergeReplace, TagTitle, 1); err == nil {
+		t.Error("Adding unsigned integer to string tag must fail")
+	}
+	if err := l.AddTime(TagMergeReplace, TagGeoLocationLatitude, dt); err == nil {
+		t.Error("Adding time to floating point tag must fail")
+	}
+	if err := l.AddDouble(TagMergeReplace, "not-registered-tag", 1); err == nil {
+		t.Error("Adding unregistered tag must fail")
+	}
+}
+
+func TestTagList_mergeNil(t *testing.T) {
+	l := NewTagList()
+	if err := l.AddString(TagMergeReplace, TagTitle, "test"); err != nil {
+		t.Fatalf("Failed to add title: %v", err)
+	}
+	m := l.Merge(nil, TagMergeReplace)
+	if s, ok := m.GetString(TagTitle); !ok || s != "test" {
+		t.Errorf("Merging nil must return a copy, but got title %s", s)
+	}
+}
+
+func TestMergeTags(t *testing.T) {
+	tags := NewTagList()
+	if err := tags.Add(TagMergeReplace, TagTitle, "test"); err != nil {
+		t.Fatalf("Failed to add tag: %v", err)
+	}
+
+	e, err := NewElementFromFactory("fakesink", "")
+	if err != nil {
+		t.Fatalf("Failed to create element: %v", err)
+	}
+	if err := e.MergeTags(tags, TagMergeReplace); err == nil {
+		t.Error("Merging tags to non tag setter must fail")
+	}
+
+	setter, err := NewElementFromFactory("vorbisenc", "")
+	if err != nil {
+		t.Skip("vorbisenc is not available")
+	}
+	if err := setter.MergeTags(tags, TagMergeReplace); err != nil {
+		t.Errorf("Failed to merge tags: %v", err)
+	}
+}
@@ -0,0 +1,448 @@
+// Copyright 2026 SEQSENSE, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gst
+
+// #cgo pkg-config: gobject-2.0 gstreamer-1.0
+// #include "gvalue.h"
+import "C"
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+	"unsafe"
+)
+
+// goValue converts the GValue to the corresponding Go value.
+func goValue(v *C.GValue) (interface{}, error) {
+	t := C.getValueType(v)
+	switch C.g_type_fundamental(t) {
+	case C.G_TYPE_BOOLEAN:
+		return C.g_value_get_boolean(v) != 0, nil
+	case C.G_TYPE_CHAR:
+		return int8(C.g_value_get_schar(v)), nil
+	case C.G_TYPE_UCHAR:
+		return uint8(C.g_value_get_uchar(v)), nil
+	case C.G_TYPE_INT:
+		return int(C.g_value_get_int(v)), nil
+	case C.G_TYPE_UINT:
+		return uint(C.g_value_get_uint(v)), nil
+	case C.G_TYPE_LONG:
+		return int64(C.g_value_get_long(v)), nil
+	case C.G_TYPE_ULONG:
+		return uint64(C.g_value_get_ulong(v)), nil
+	case C.G_TYPE_INT64:
+		return int64(C.g_value_get_int64(v)), nil
+	case C.G_TYPE_UINT64:
+		return uint64(C.g_value_get_uint64(v)), nil
+	case C.G_TYPE_FLOAT:
+		return float32(C.g_value_get_float(v)), nil
+	case C.G_TYPE_DOUBLE:
+		return float64(C.g_value_get_double(v)), nil
+	case C.G_TYPE_STRING:
+		return C.GoString(C.g_value_get_string(v)), nil
+	case C.G_TYPE_ENUM:
+		return goEnumValue(t, C.g_value_get_enum(v)), nil
+	case C.G_TYPE_FLAGS:
+		return goFlagsValue(t, C.g_value_get_flags(v)), nil
+	case C.G_TYPE_OBJECT:
+		p := unsafe.Pointer(C.g_value_get_object(v))
+		switch {
+		case p == nil:
+			return nil, nil
+		case C.isElement(p) != 0:
+			return newElementRef(p), nil
+		case C.isPad(p) != 0:
+			return newPadRef(p), nil
+		default:
+			return newObjectRef(p), nil
+		}
+	case C.G_TYPE_PARAM:
+		p := C.g_value_get_param(v)
+		if p == nil {
+			return nil, nil
+		}
+		return newParamSpec(p), nil
+	case C.G_TYPE_POINTER:
+		return unsafe.Pointer(C.g_value_get_pointer(v)), nil
+	case C.G_TYPE_BOXED:
+		if val, ok := goGstBoxedValue(v, t); ok {
+			return val, nil
+		}
+		return unsafe.Pointer(C.g_value_get_boxed(v)), nil
+	default:
+		return goGstValue(v, t)
+	}
+}
+
+// goValueTypes returns the Go types which goValue may return for the GType.
+// nil is returned if the Go type is not determined by the GType.
+func goValueTypes(t C.GType) []reflect.Type {
+	var val interface{}
+	switch C.g_type_fundamental(t) {
+	case C.G_TYPE_BOOLEAN:
+		val = false
+	case C.G_TYPE_CHAR:
+		val = int8(0)
+	case C.G_TYPE_UCHAR:
+		val = uint8(0)
+	case C.G_TYPE_INT:
+		val = int(0)
+	case C.G_TYPE_UINT:
+		val = uint(0)
+	case C.G_TYPE_LONG, C.G_TYPE_INT64:
+		val = int64(0)
+	case C.G_TYPE_ULONG, C.G_TYPE_UINT64:
+		val = uint64(0)
+	case C.G_TYPE_FLOAT:
+		val = float32(0)
+	case C.G_TYPE_DOUBLE:
+		val = float64(0)
+	case C.G_TYPE_STRING:
+		val = ""
+	case C.G_TYPE_ENUM:
+		val = EnumValue{}
+	case C.G_TYPE_FLAGS:
+		val = FlagsValue{}
+	case C.G_TYPE_OBJECT:
+		elementType := reflect.TypeOf(&Element{})
+		padType := reflect.TypeOf(&Pad{})
+		switch {
+		case C.g_type_is_a(t, C.gst_element_get_type()) != 0:
+			return []reflect.Type{elementType}
+		case C.g_type_is_a(t, C.gst_pad_get_type()) != 0:
+			return []reflect.Type{padType}
+		case C.g_type_is_a(C.gst_element_get_type(), t) != 0:
+			// Base type of the elements and pads like GObject and GstObject.
+			return []reflect.Type{reflect.TypeOf(&Object{}), elementType, padType}
+		default:
+			val = &Object{}
+		}
+	case C.G_TYPE_PARAM:
+		val = &ParamSpec{}
+	case C.G_TYPE_POINTER:
+		val = unsafe.Pointer(nil)
+	case C.G_TYPE_BOXED:
+		switch t {
+		case C.gstCapsType():
+			val = &Caps{}
+		case C.gstStructureType():
+			val = &Structure{}
+		case C.gstDateTimeType(), C.gDateType():
+			val = time.Time{}
+		default:
+			val = unsafe.Pointer(nil)
+		}
+	default:
+		switch t {
+		case C.gstFractionType():
+			val = Fraction{}
+		case C.gstIntRangeType():
+			val = IntRange{}
+		case C.gstDoubleRangeType():
+			val = DoubleRange{}
+		case C.gstFractionRangeType():
+			val = FractionRange{}
+		case C.gstListType():
+			val = ValueList{}
+		case C.gstArrayType():
+			val = ValueArray{}
+		default:
+			return nil
+		}
+	}
+	return []reflect.Type{reflect.TypeOf(val)}
+}
+
+// canPassAs returns true if the value of the type from can be passed as the type to
+// by assignment or numeric conversion.
+func canPassAs(from, to reflect.Type) bool {
+	if from.AssignableTo(to) {
+		return true
+	}
+	if to.Kind() == reflect.String && from.Kind() != reflect.String {
+		// Integers are convertible to string as a rune, but it is not intended.
+		return false
+	}
+	return from.ConvertibleTo(to)
+}
+
+// setGValue stores the Go value to the GValue.
+// The GValue must be initialized by the destination type beforehand.
+func setGValue(v *C.GValue, val interface{}) error {
+	t := C.getValueType(v)
+	switch C.g_type_fundamental(t) {
+	case C.G_TYPE_BOOLEAN:
+		b, ok := val.(bool)
+		if !ok {
+			return errValueType(val, t)
+		}
+		C.g_value_set_boolean(v, gboolean(b))
+	case C.G_TYPE_CHAR:
+		i, err := toInt64(val, t, math.MinInt8, math.MaxInt8)
+		if err != nil {
+			return err
+		}
+		C.g_value_set_schar(v, C.gint8(i))
+	case C.G_TYPE_UCHAR:
+		u, err := toUint64(val, t, math.MaxUint8)
+		if err != nil {
+			return err
+		}
+		C.g_value_set_uchar(v, C.guchar(u))
+	case C.G_TYPE_INT:
+		i, err := toInt64(val, t, math.MinInt32, math.MaxInt32)
+		if err != nil {
+			return err
+		}
+		C.g_value_set_int(v, C.gint(i))
+	case C.G_TYPE_UINT:
+		u, err := toUint64(val, t, math.MaxUint32)
+		if err != nil {
+			return err
+		}
+		C.g_value_set_uint(v, C.guint(u))
+	case C.G_TYPE_LONG:
+		i, err := toInt64(val, t, math.MinInt64, math.MaxInt64)
+		if err != nil {
+			return err
+		}
+		C.g_value_set_long(v, C.glong(i))
+	case C.G_TYPE_ULONG:
+		u, err := toUint64(val, t, math.MaxUint64)
+		if err != nil {
+			return err
+		}
+		C.g_value_set_ulong(v, C.gulong(u))
+	case C.G_TYPE_INT64:
+		i, err := toInt64(val, t, math.MinInt64, math.MaxInt64)
+		if err != nil {
+			return err
+		}
+		C.g_value_set_int64(v, C.gint64(i))
+	case C.G_TYPE_UINT64:
+		u, err := toUint64(val, t, math.MaxUint64)
+		if err != nil {
+			return err
+		}
+		C.g_value_set_uint64(v, C.guint64(u))
+	case C.G_TYPE_FLOAT:
+		f, err := toFloat64(val, t)
+		if err != nil {
+			return err
+		}
+		C.g_value_set_float(v, C.gfloat(f))
+	case C.G_TYPE_DOUBLE:
+		f, err := toFloat64(val, t)
+		if err != nil {
+			return err
+		}
+		C.g_value_set_double(v, C.gdouble(f))
+	case C.G_TYPE_STRING:
+		s, ok := val.(string)
+		if !ok {
+			return errValueType(val, t)
+		}
+		cStr := C.CString(s)
+		defer C.free(unsafe.Pointer(cStr))
+		C.g_value_set_string(v, cStr)
+	case C.G_TYPE_ENUM:
+		e, err := enumValueOf(val, t)
+		if err != nil {
+			return err
+		}
+		C.g_value_set_enum(v, e)
+	case C.G_TYPE_FLAGS:
+		f, err := flagsValueOf(val, t)
+		if err != nil {
+			return err
+		}
+		C.g_value_set_flags(v, f)
+	case C.G_TYPE_OBJECT:
+		var p unsafe.Pointer
+		switch val := val.(type) {
+		case nil:
+		case *Element:
+			p = val.UnsafePointer()
+		case *Pad:
+			p = val.UnsafePointer()
+		case *Object:
+			p = val.UnsafePointer()
+		case unsafe.Pointer:
+			p = val
+		default:
+			return errValueType(val, t)
+		}
+		if p != nil && C.g_type_is_a(C.getObjectType(p), t) == 0 {
+			return fmt.Errorf("Object of %s can not be stored to GValue type %s",
+				typeName(C.getObjectType(p)), typeName(t))
+		}
+		C.g_value_set_object(v, C.gpointer(p))
+	case C.G_TYPE_POINTER:
+		p, ok := val.(unsafe.Pointer)
+		if !ok && val != nil {
+			return errValueType(val, t)
+		}
+		C.g_value_set_pointer(v, C.gpointer(p))
+	case C.G_TYPE_BOXED:
+		if ok, err := setGstBoxedValue(v, t, val); ok {
+			return err
+		}
+		p, ok := val.(unsafe.Pointer)
+		if !ok && val != nil {
+			return errValueType(val, t)
+		}
+		C.g_value_set_boxed(v, C.gconstpointer(p))
+	default:
+		return setGstValue(v, t, val)
+	}
+	return nil
+}
+
+// gValueTypeOf returns the GType to store the Go value.
+func gValueTypeOf(val interface{}) (C.GType, error) {
+	if t, ok := gstValueTypeOf(val); ok {
+		return t, nil
+	}
+	switch val := val.(type) {
+	case bool:
+		return C.G_TYPE_BOOLEAN, nil
+	case int, int8, int16, int32:
+		return C.G_TYPE_INT, nil
+	case uint, uint8, uint16, uint32:
+		return C.G_TYPE_UINT, nil
+	case int64:
+		return C.G_TYPE_INT64, nil
+	case uint64:
+		return C.G_TYPE_UINT64, nil
+	case float32:
+		return C.G_TYPE_FLOAT, nil
+	case float64:
+		return C.G_TYPE_DOUBLE, nil
+	case string:
+		return C.G_TYPE_STRING, nil
+	case *Element:
+		return C.getObjectType(val.UnsafePointer()), nil
+	case *Pad:
+		return C.getObjectType(val.UnsafePointer()), nil
+	case *Object:
+		return C.getObjectType(val.UnsafePointer()), nil
+	default:
+		return 0, fmt.Errorf("Value of %T can not be stored to GValue", val)
+	}
+}
+
+// initGValue initializes the GValue by the type of the Go value and stores it.
+func initGValue(v *C.GValue, val interface{}) error {
+	t, err := gValueTypeOf(val)
+	if err != nil {
+		return err
+	}
+	C.g_value_init(v, t)
+	return setGValue(v, val)
+}
+
+func typeName(t C.GType) string {
+	return C.GoString(C.g_type_name(t))
+}
+
+func gboolean(b bool) C.gboolean {
+	if b {
+		return C.TRUE
+	}
+	return C.FALSE
+}
+
+func errValueType(val interface{}, t C.GType) error {
+	return fmt.Errorf("Value of %T can not be stored to GValue type %s", val, typeName(t))
+}
+
+func errValueRange(val interface{}, t C.GType) error {
+	return fmt.Errorf("Value %v overflows GValue type %s", val, typeName(t))
+}
+
+func toInt64(val interface{}, t C.GType, min, max int64) (int64, error) {
+	var i int64
+	switch val := val.(type) {
+	case int:
+		i = int64(val)
+	case int8:
+		i = int64(val)
+	case int16:
+		i = int64(val)
+	case int32:
+		i = int64(val)
+	case int64:
+		i = val
+	case uint, uint8, uint16, uint32, uint64:
+		u, err := toUint64(val, t, math.MaxInt64)
+		if err != nil {
+			return 0, err
+		}
+		i = int64(u)
+	default:
+		return 0, errValueType(val, t)
+	}
+	if i < min || max < i {
+		return 0, errValueRange(val, t)
+	}
+	return i, nil
+}
+
+func toUint64(val interface{}, t C.GType, max uint64) (uint64, error) {
+	var u uint64
+	switch val := val.(type) {
+	case uint:
+		u = uint64(val)
+	case uint8:
+		u = uint64(val)
+	case uint16:
+		u = uint64(val)
+	case uint32:
+		u = uint64(val)
+	case uint64:
+		u = val
+	case int, int8, int16, int32, int64:
+		i, err := toInt64(val, t, 0, math.MaxInt64)
+		if err != nil {
+			return 0, err
+		}
+		u = uint64(i)
+	default:
+		return 0, errValueType(val, t)
+	}
+	if max < u {
+		return 0, errValueRange(val, t)
+	}
+	return u, nil
+}
+
+func toFloat64(val interface{}, t C.GType) (float64, error) {
+	switch val := val.(type) {
+	case float32:
+		return float64(val), nil
+	case float64:
+		return val, nil
+	case int, int8, int16, int32, int64:
+		i, err := toInt64(val, t, math.MinInt64, math.MaxInt64)
+		return float64(i), err
+	case uint, uint8, uint16, uint32, uint64:
+		u, err := toUint64(val, t, math.MaxUint64)
+		return float64(u), err
+	default:
+		return 0, errValueType(val, t)
+	}
+}